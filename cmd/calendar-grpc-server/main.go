@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pgillich/date_calculator/pkg/calendarpb"
+	"github.com/pgillich/date_calculator/pkg/grpcserver"
+	"github.com/pgillich/date_calculator/pkg/registry"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	listen := flag.String("listen", ":9090", "listen address")
+	flag.Parse()
+
+	calendars, err := registry.Builtin()
+	if err != nil {
+		fmt.Printf("unable to init calendars: %s\n", err)
+		os.Exit(1)
+	}
+
+	listener, err := net.Listen("tcp", *listen)
+	if err != nil {
+		fmt.Printf("unable to listen: %s\n", err)
+		os.Exit(1)
+	}
+
+	grpcServer := grpc.NewServer()
+	calendarpb.RegisterCalendarServiceServer(grpcServer, grpcserver.New(calendars))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+
+	fmt.Printf("listening on %s\n", *listen)
+
+	if err := grpcServer.Serve(listener); err != nil {
+		fmt.Printf("unable to serve: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/registry"
+	"github.com/pgillich/date_calculator/pkg/server"
+)
+
+const (
+	readTimeout     = 10 * time.Second
+	writeTimeout    = 10 * time.Second
+	shutdownTimeout = 10 * time.Second
+)
+
+func main() {
+	listen := flag.String("listen", ":8080", "listen address")
+	flag.Parse()
+
+	calendars, err := registry.Builtin()
+	if err != nil {
+		fmt.Printf("unable to init calendars: %s\n", err)
+		os.Exit(1)
+	}
+
+	httpServer := &http.Server{ //nolint:exhaustivestruct // optional fields
+		Addr:         *listen,
+		Handler:      server.New(calendars),
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			fmt.Printf("unable to shutdown: %s\n", err)
+		}
+	}()
+
+	fmt.Printf("listening on %s\n", *listen)
+
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Printf("unable to serve: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/registry"
+)
+
+const (
+	monthFormat  = "2006-01"
+	daysPerWeek  = 7
+	slotDuration = 30 * time.Minute
+	slotsPerDay  = int(24 * time.Hour / slotDuration)
+)
+
+// calSpan is the working time of -submit and -hours, highlighted on the grids.
+type calSpan struct {
+	from time.Time
+	to   time.Time
+}
+
+func (span calSpan) overlaps(from, to time.Time) bool {
+	return !span.from.IsZero() && from.Before(span.to) && span.from.Before(to)
+}
+
+// runCal prints the month grid (or the week grid with -week) of a calendar,
+// highlighting the working time of -submit and -hours.
+func runCal(args []string, calendars *registry.Registry, stdout io.Writer) error {
+	flags := flag.NewFlagSet("cal", flag.ContinueOnError)
+	calendarName := flags.String("calendar", registry.DefaultName, "calendar name")
+	month := flags.String("month", "", "month to print, in 2006-01 format (default: month of -submit or now)")
+	week := flags.String("week", "", "print the week of the date with the working hours, in 2006-01-02 format")
+	submit := flags.String("submit", "", "submit time in the time format of the calendar, highlighted with -hours")
+	hours := flags.Float64("hours", 0, "turnaround in working hours, highlighted from -submit")
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w: %s", ErrUsage, err.Error())
+	}
+
+	calendarNamed, err := calendars.Calendar(*calendarName)
+	if err != nil {
+		return err
+	}
+
+	span := calSpan{from: time.Time{}, to: time.Time{}}
+	location := time.Local
+	monthAt := time.Now()
+
+	if *submit != "" {
+		submitAt, err := calendarNamed.ParseTime(*submit)
+		if err != nil {
+			return fmt.Errorf("%w: invalid -submit: %s", ErrUsage, err.Error())
+		}
+
+		dueAt, err := calendarNamed.CalculateDueDate(submitAt, *hours)
+		if err != nil {
+			return err
+		}
+
+		span = calSpan{from: submitAt, to: dueAt}
+		location = submitAt.Location()
+		monthAt = submitAt
+	}
+
+	if *week != "" {
+		weekDate, err := calendar.ParseDate(*week)
+		if err != nil {
+			return fmt.Errorf("%w: invalid -week: %s", ErrUsage, err.Error())
+		}
+
+		return writeWeek(stdout, calendarNamed, weekDate, location, span)
+	}
+
+	if *month != "" {
+		if monthAt, err = time.Parse(monthFormat, *month); err != nil {
+			return fmt.Errorf("%w: invalid -month: %s", ErrUsage, err.Error())
+		}
+	}
+
+	return writeMonth(stdout, calendarNamed, calendar.NewDate(monthAt.Year(), monthAt.Month(), 1), location, span)
+}
+
+// weekStart returns the Monday of the week of the date.
+func weekStart(date calendar.Date) calendar.Date {
+	return date.AddDays(-(int(date.Weekday()) + daysPerWeek - int(time.Monday)) % daysPerWeek)
+}
+
+// writeMonth prints the days of the month with a mark, and the non-regular days with their notes.
+// The days having highlighted working time are in brackets.
+func writeMonth(
+	writer io.Writer, calendarNamed *calendar.Calendar, first calendar.Date, location *time.Location, span calSpan,
+) error {
+	builder := strings.Builder{}
+	notes := []string{}
+
+	fmt.Fprintf(&builder, "%s %d\n", first.Month, first.Year)
+
+	for day := 0; day < daysPerWeek; day++ {
+		fmt.Fprintf(&builder, " %.2s   ", time.Weekday((int(time.Monday)+day)%daysPerWeek))
+	}
+
+	builder.WriteString("\n")
+
+	for date := weekStart(first); date.Month == first.Month || date.Before(first); date = date.AddDays(daysPerWeek) {
+		for day := 0; day < daysPerWeek; day++ {
+			dayDate := date.AddDays(day)
+			if dayDate.Month != first.Month {
+				builder.WriteString("      ")
+
+				continue
+			}
+
+			mark, note := dayMark(calendarNamed, dayDate)
+			if note != "" {
+				notes = append(notes, fmt.Sprintf("%s %.3s  %s", dayDate, dayDate.Weekday(), note))
+			}
+
+			open, closing := " ", " "
+			if workOverlaps(calendarNamed, dayDate, location, span) {
+				open, closing = "[", "]"
+			}
+
+			fmt.Fprintf(&builder, "%s%2d%s%s ", open, dayDate.Day, mark, closing)
+		}
+
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString("\n.: day off  *: holiday  ~: partial  +: working override  -: non-working override")
+	builder.WriteString("  [ ]: highlighted\n")
+
+	for _, note := range notes {
+		builder.WriteString(note + "\n")
+	}
+
+	writeSpan(&builder, calendarNamed, span)
+
+	return writeTrimmed(writer, builder.String())
+}
+
+// writeWeek prints the working hours of the week of the date, by half an hour.
+// The highlighted working time is '#', other working time is '='.
+func writeWeek(
+	writer io.Writer, calendarNamed *calendar.Calendar, date calendar.Date, location *time.Location, span calSpan,
+) error {
+	builder := strings.Builder{}
+	slotsPerQuarter := slotsPerDay / 4 //nolint:gomnd // hour labels at 0, 6, 12 and 18
+
+	fmt.Fprintf(&builder, "%-16s", "")
+
+	for slot := 0; slot < slotsPerDay; slot += slotsPerQuarter {
+		fmt.Fprintf(&builder, "%-*d", slotsPerQuarter, int(time.Duration(slot)*slotDuration/time.Hour))
+	}
+
+	builder.WriteString("\n")
+
+	for day := 0; day < daysPerWeek; day++ {
+		dayDate := weekStart(date).AddDays(day)
+		begins, ends, working := calendarNamed.WorkHours(dayDate)
+
+		fmt.Fprintf(&builder, "%.3s %s  ", dayDate.Weekday(), dayDate)
+
+		for slot := 0; slot < slotsPerDay; slot++ {
+			from, to := time.Duration(slot)*slotDuration, time.Duration(slot+1)*slotDuration
+
+			switch {
+			case !working || to <= begins || ends <= from:
+				builder.WriteString(".")
+			case span.overlaps(dayDate.In(location).Add(from), dayDate.In(location).Add(to)):
+				builder.WriteString("#")
+			default:
+				builder.WriteString("=")
+			}
+		}
+
+		if working {
+			fmt.Fprintf(&builder, "  %s - %s", formatClock(begins), formatClock(ends))
+		}
+
+		if _, note := dayMark(calendarNamed, dayDate); note != "" {
+			builder.WriteString("  " + note)
+		}
+
+		builder.WriteString("\n")
+	}
+
+	writeSpan(&builder, calendarNamed, span)
+
+	return writeTrimmed(writer, builder.String())
+}
+
+// writeTrimmed writes the lines without their trailing spaces.
+func writeTrimmed(writer io.Writer, text string) error {
+	lines := strings.Split(text, "\n")
+	for l, line := range lines {
+		lines[l] = strings.TrimRight(line, " ")
+	}
+
+	_, err := io.WriteString(writer, strings.Join(lines, "\n"))
+
+	return err //nolint:wrapcheck // written to the caller's writer
+}
+
+func writeSpan(builder *strings.Builder, calendarNamed *calendar.Calendar, span calSpan) {
+	if span.from.IsZero() {
+		return
+	}
+
+	fmt.Fprintf(builder, "\nsubmitted %s, due %s\n",
+		calendarNamed.FormatTime(span.from), calendarNamed.FormatTime(span.to),
+	)
+}
+
+// workOverlaps reports whether the working hours of the date overlap the span.
+func workOverlaps(calendarNamed *calendar.Calendar, date calendar.Date, location *time.Location, span calSpan) bool {
+	begins, ends, working := calendarNamed.WorkHours(date)
+
+	return working && span.overlaps(date.In(location).Add(begins), date.In(location).Add(ends))
+}
+
+// dayMark returns the mark of the date on the month grid, and a note, if it's not a regular day.
+func dayMark(calendarNamed *calendar.Calendar, date calendar.Date) (string, string) {
+	if override, is := calendarNamed.Override(date); is {
+		if !override.Working {
+			return "-", "override, non-working"
+		}
+
+		return "+", fmt.Sprintf("override, working %s - %s",
+			formatClock(override.WorkBegins), formatClock(override.WorkEnds),
+		)
+	}
+
+	for _, holiday := range calendarNamed.Holidays(date, date) {
+		if holiday.IsPartial() && calendarNamed.IsWorkday(date) {
+			return "~", fmt.Sprintf("holiday %s, working %s - %s",
+				holiday.Name, formatClock(holiday.WorkBegins), formatClock(holiday.WorkEnds),
+			)
+		}
+
+		return "*", "holiday " + holiday.Name
+	}
+
+	if !calendarNamed.IsWorkday(date) {
+		return ".", ""
+	}
+
+	return " ", ""
+}
+
+func formatClock(clock time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(clock/time.Hour), int(clock%time.Hour/time.Minute))
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+//nolint:gochecknoglobals // flag of the test binary
+var updateGolden = flag.Bool("update", false, "update the golden files of testdata")
+
+func (s *DatecalcTestSuite) calCalendar() *calendar.Calendar {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays: []calendar.HolidayRule{
+			calendar.FixedHoliday{Name: "Founders' Day", Month: time.December, Day: 1},
+			calendar.PartialHoliday{
+				Rule:       calendar.FixedHoliday{Name: "Christmas Eve", Month: time.December, Day: 24},
+				WorkBegins: 9 * time.Hour,
+				WorkEnds:   13 * time.Hour,
+			},
+			calendar.FixedHoliday{Name: "Christmas", Month: time.December, Day: 25},
+		},
+		Overrides: []calendar.DayOverride{
+			{
+				Date:       calendar.NewDate(2021, time.December, 11),
+				Working:    true,
+				WorkBegins: 8 * time.Hour,
+				WorkEnds:   12 * time.Hour,
+			},
+			{Date: calendar.NewDate(2021, time.December, 31), Working: false},
+		},
+	})
+	s.Require().NoError(err)
+
+	return calendarTest
+}
+
+func (s *DatecalcTestSuite) calSpan(calendarTest *calendar.Calendar) calSpan {
+	submitAt, err := calendarTest.ParseTime("2021-12-23T15:00:00+01:00")
+	s.Require().NoError(err)
+
+	dueAt, err := calendarTest.CalculateDueDate(submitAt, 8)
+	s.Require().NoError(err)
+
+	return calSpan{from: submitAt, to: dueAt}
+}
+
+// assertGolden compares the output to testdata/name, or writes it with -update.
+func (s *DatecalcTestSuite) assertGolden(name string, output []byte) {
+	path := filepath.Join("testdata", name)
+
+	if *updateGolden {
+		s.Require().NoError(os.WriteFile(path, output, 0o600))
+	}
+
+	expected, err := os.ReadFile(path)
+	s.Require().NoError(err)
+	s.Assert().Equal(string(expected), string(output))
+}
+
+func (s *DatecalcTestSuite) TestWriteMonth() {
+	calendarTest := s.calCalendar()
+	span := s.calSpan(calendarTest)
+	output := &bytes.Buffer{}
+
+	s.Require().NoError(writeMonth(
+		output, calendarTest, calendar.NewDate(2021, time.December, 1), span.from.Location(), span,
+	))
+	s.assertGolden("month.golden", output.Bytes())
+}
+
+func (s *DatecalcTestSuite) TestWriteWeek() {
+	calendarTest := s.calCalendar()
+	span := s.calSpan(calendarTest)
+	output := &bytes.Buffer{}
+
+	s.Require().NoError(writeWeek(
+		output, calendarTest, calendar.NewDate(2021, time.December, 22), span.from.Location(), span,
+	))
+	s.assertGolden("week.golden", output.Bytes())
+}
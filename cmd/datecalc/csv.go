@@ -0,0 +1,175 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/registry"
+)
+
+// csvColumns are the column names of the input and the added output columns.
+type csvColumns struct {
+	submit string
+	hours  string
+	due    string
+	err    string
+	reason string
+}
+
+// runCSV reads tickets in CSV and writes them with their due dates, row by row, so the size of the file is unlimited.
+// The rows having invalid values get the error (and the reason of an invalid submit time) instead of the due date.
+func runCSV(args []string, calendars *registry.Registry, stdout io.Writer) error {
+	flags := flag.NewFlagSet("csv", flag.ContinueOnError)
+	calendarName := flags.String("calendar", registry.DefaultName, "calendar name")
+	inPath := flags.String("in", "", "input CSV file (default: standard input)")
+	outPath := flags.String("out", "", "output CSV file (default: standard output)")
+	columns := csvColumns{}
+	flags.StringVar(&columns.submit, "submit-column", "submitAt", "column of the submit time (calendar time format)")
+	flags.StringVar(&columns.hours, "hours-column", "turnaroundHours", "column of the turnaround in working hours")
+	flags.StringVar(&columns.due, "due-column", "dueAt", "added column of the due date")
+	flags.StringVar(&columns.err, "error-column", "error", "added column of the error")
+	flags.StringVar(&columns.reason, "reason-column", "errorReason", "added column of the invalid submit time reason")
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w: %s", ErrUsage, err.Error())
+	}
+
+	calendarNamed, err := calendars.Calendar(*calendarName)
+	if err != nil {
+		return err
+	}
+
+	reader := io.Reader(os.Stdin)
+
+	if *inPath != "" {
+		inFile, err := os.Open(*inPath)
+		if err != nil {
+			return fmt.Errorf("unable to open input: %w", err)
+		}
+		defer inFile.Close()
+
+		reader = inFile
+	}
+
+	if *outPath == "" {
+		return writeDueDatesCSV(reader, stdout, calendarNamed, columns)
+	}
+
+	outFile, err := os.Create(*outPath)
+	if err != nil {
+		return fmt.Errorf("unable to create output: %w", err)
+	}
+
+	if err := writeDueDatesCSV(reader, outFile, calendarNamed, columns); err != nil {
+		outFile.Close()
+
+		return err
+	}
+
+	return outFile.Close() //nolint:wrapcheck // the error of the output file
+}
+
+func writeDueDatesCSV(reader io.Reader, writer io.Writer, calendarNamed *calendar.Calendar, columns csvColumns) error {
+	csvReader := csv.NewReader(reader)
+	csvReader.ReuseRecord = true
+	// the rows having wrong number of fields are reported in their error column
+	csvReader.FieldsPerRecord = -1
+	csvWriter := csv.NewWriter(writer)
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return fmt.Errorf("unable to read header: %w", err)
+	}
+
+	submitColumn, hoursColumn := -1, -1
+
+	for c, name := range header {
+		switch name {
+		case columns.submit:
+			submitColumn = c
+		case columns.hours:
+			hoursColumn = c
+		}
+	}
+
+	if submitColumn < 0 || hoursColumn < 0 {
+		return fmt.Errorf("%w: missing column %s or %s", ErrUsage, columns.submit, columns.hours)
+	}
+
+	if err := csvWriter.Write(append(header, columns.due, columns.err, columns.reason)); err != nil {
+		return fmt.Errorf("unable to write header: %w", err)
+	}
+
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("unable to read: %w", err)
+		}
+
+		dueAt, errMessage, reason := "", "", ""
+
+		if len(record) == len(header) {
+			dueAt, errMessage, reason = csvDueDate(calendarNamed, record[submitColumn], record[hoursColumn])
+		} else {
+			errMessage = fmt.Sprintf("wrong number of fields: %d, expected %d", len(record), len(header))
+			record = resizeRecord(record, len(header))
+		}
+
+		if err := csvWriter.Write(append(record, dueAt, errMessage, reason)); err != nil {
+			return fmt.Errorf("unable to write: %w", err)
+		}
+	}
+
+	csvWriter.Flush()
+
+	return csvWriter.Error() //nolint:wrapcheck // the error of the output
+}
+
+// resizeRecord truncates or pads the record to size fields, so the added columns are under their header.
+func resizeRecord(record []string, size int) []string {
+	if len(record) > size {
+		return record[:size]
+	}
+
+	return append(record, make([]string, size-len(record))...)
+}
+
+// csvDueDate returns the due date, or the error and the reason of an invalid submit time.
+func csvDueDate(calendarNamed *calendar.Calendar, submit, hours string) (string, string, string) {
+	submitAt, err := calendarNamed.ParseTime(submit)
+	if err != nil {
+		return "", err.Error(), ""
+	}
+
+	turnaroundDurationHour, err := strconv.ParseFloat(hours, 64)
+	if err != nil {
+		return "", fmt.Sprintf("invalid turnaround: %s", hours), ""
+	}
+
+	if err := calendar.ValidateTurnaroundHours(turnaroundDurationHour); err != nil {
+		return "", err.Error(), ""
+	}
+
+	dueAt, err := calendarNamed.CalculateDueDate(submitAt, turnaroundDurationHour)
+
+	var submitTimeError *calendar.SubmitTimeError
+
+	switch {
+	case errors.As(err, &submitTimeError):
+		return "", err.Error(), string(submitTimeError.Reason)
+	case err != nil:
+		return "", err.Error(), ""
+	default:
+		return calendarNamed.FormatTime(dueAt), "", ""
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+)
+
+func (s *DatecalcTestSuite) TestWriteDueDatesCSV() {
+	columns := csvColumns{
+		submit: "submitAt",
+		hours:  "turnaroundHours",
+		due:    "dueAt",
+		err:    "error",
+		reason: "errorReason",
+	}
+
+	testCases := []struct {
+		name string
+
+		input string
+
+		expectedOutput string
+		expectedErr    error
+	}{
+		{
+			name: "Good rows",
+			input: "id,submitAt,turnaroundHours\n" +
+				"1,2021-10-13T16:00:00+02:00,2\n" +
+				"2,2021-10-15T16:00:00+02:00,16\n",
+			expectedOutput: "id,submitAt,turnaroundHours,dueAt,error,errorReason\n" +
+				"1,2021-10-13T16:00:00+02:00,2,2021-10-14T10:00:00+02:00,,\n" +
+				"2,2021-10-15T16:00:00+02:00,16,2021-10-19T16:00:00+02:00,,\n",
+			expectedErr: nil,
+		},
+		{
+			name: "Bad rows",
+			input: "id,submitAt,turnaroundHours\n" +
+				"1,2021-10-16T10:00:00+02:00,2\n" +
+				"2,2021-10-13T16:00:00+02:00,two\n" +
+				"3,yesterday,2\n" +
+				"4,2021-10-13T16:00:00+02:00,NaN\n" +
+				"5,2021-10-13T16:00:00+02:00,-3\n" +
+				"6,2021-10-13T16:00:00+02:00,1e300\n",
+			expectedOutput: "id,submitAt,turnaroundHours,dueAt,error,errorReason\n" +
+				"1,2021-10-16T10:00:00+02:00,2,,\"invalid submit datetime: 2021-10-16T10:00:00+02:00, " +
+				"must be Monday - Friday\",weekday\n" +
+				"2,2021-10-13T16:00:00+02:00,two,,invalid turnaround: two,\n" +
+				"3,yesterday,2,,\"invalid time string: parsing time \"\"yesterday\"\" as " +
+				"\"\"2006-01-02T15:04:05Z07:00\"\": cannot parse \"\"yesterday\"\" as \"\"2006\"\"\",\n" +
+				"4,2021-10-13T16:00:00+02:00,NaN,,invalid turnaround: NaN hours,\n" +
+				"5,2021-10-13T16:00:00+02:00,-3,,invalid turnaround: -3 hours,\n" +
+				"6,2021-10-13T16:00:00+02:00,1e300,,invalid turnaround: 1e+300 hours,\n",
+			expectedErr: nil,
+		},
+		{
+			name: "Ragged rows",
+			input: "id,submitAt,turnaroundHours\n" +
+				"1,2021-10-13T16:00:00+02:00\n" +
+				"2,2021-10-13T16:00:00+02:00,2,extra\n" +
+				"3,2021-10-13T16:00:00+02:00,2\n",
+			expectedOutput: "id,submitAt,turnaroundHours,dueAt,error,errorReason\n" +
+				"1,2021-10-13T16:00:00+02:00,,,\"wrong number of fields: 2, expected 3\",\n" +
+				"2,2021-10-13T16:00:00+02:00,2,,\"wrong number of fields: 4, expected 3\",\n" +
+				"3,2021-10-13T16:00:00+02:00,2,2021-10-14T10:00:00+02:00,,\n",
+			expectedErr: nil,
+		},
+		{
+			name:           "Missing column",
+			input:          "id,submitAt\n1,2021-10-13T16:00:00+02:00\n",
+			expectedOutput: "",
+			expectedErr:    ErrUsage,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			output := &bytes.Buffer{}
+
+			err := writeDueDatesCSV(strings.NewReader(testCase.input), output, s.testCalendar(), columns)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+			s.Assert().Equal(testCase.expectedOutput, output.String())
+		})
+	}
+}
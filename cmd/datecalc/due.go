@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/registry"
+)
+
+// runDue prints the due date, and the steps of the calculation, if -explain is set.
+func runDue(args []string, calendars *registry.Registry, stdout io.Writer) error {
+	flags := flag.NewFlagSet("due", flag.ContinueOnError)
+	calendarName := flags.String("calendar", registry.DefaultName, "calendar name")
+	submit := flags.String("submit", "", "submit time in the time format of the calendar (RFC 3339)")
+	hours := flags.Float64("hours", 0, "turnaround in working hours")
+	explain := flags.Bool("explain", false, "print the steps of the calculation")
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w: %s", ErrUsage, err.Error())
+	}
+
+	calendarNamed, err := calendars.Calendar(*calendarName)
+	if err != nil {
+		return err
+	}
+
+	submitAt, err := calendarNamed.ParseTime(*submit)
+	if err != nil {
+		return fmt.Errorf("%w: invalid -submit: %s", ErrUsage, err.Error())
+	}
+
+	if !*explain {
+		dueAt, err := calendarNamed.CalculateDueDate(submitAt, *hours)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(stdout, calendarNamed.FormatTime(dueAt))
+
+		return nil
+	}
+
+	trace, err := calendarNamed.CalculateDueDateTrace(submitAt, *hours)
+	if err != nil {
+		return err
+	}
+
+	return writeTrace(stdout, calendarNamed, trace)
+}
+
+func writeTrace(writer io.Writer, calendarNamed *calendar.Calendar, trace calendar.Trace) error {
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0) //nolint:gomnd // padding
+
+	fmt.Fprintf(table, "submitted\t%s\tturnaround %s\n",
+		calendarNamed.FormatTime(trace.SubmitAt), calendarNamed.FormatWorkingDuration(trace.Turnaround),
+	)
+
+	for _, step := range trace.Steps {
+		fmt.Fprintf(table, "%s\t%s - %s\t%s used\t%s remaining\t%s\n",
+			step.Kind, calendarNamed.FormatTime(step.Start), calendarNamed.FormatTime(step.End),
+			calendarNamed.FormatWorkingDuration(step.Consumed), calendarNamed.FormatWorkingDuration(step.Remaining),
+			step.Note,
+		)
+	}
+
+	fmt.Fprintf(table, "due\t%s\n", calendarNamed.FormatTime(trace.DueAt))
+
+	return table.Flush() //nolint:wrapcheck // written to the caller's writer
+}
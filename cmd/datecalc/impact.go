@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/impact"
+	"github.com/pgillich/date_calculator/pkg/registry"
+)
+
+// runImpact prints the tickets, which due dates are changed by the new calendar config.
+func runImpact(args []string, _ *registry.Registry, stdout io.Writer) error {
+	flags := flag.NewFlagSet("impact", flag.ContinueOnError)
+	oldPath := flags.String("old", "", "current calendar config file (JSON)")
+	newPath := flags.String("new", "", "new calendar config file (JSON)")
+	ticketsPath := flags.String("tickets", "", "tickets file (CSV or JSON)")
+	jsonOutput := flags.Bool("json", false, "print the report in JSON")
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w: %s", ErrUsage, err.Error())
+	}
+
+	if *oldPath == "" || *newPath == "" || *ticketsPath == "" {
+		return fmt.Errorf("%w: -old, -new and -tickets are required", ErrUsage)
+	}
+
+	oldCalendar, err := loadCalendar(*oldPath)
+	if err != nil {
+		return err
+	}
+
+	newCalendar, err := loadCalendar(*newPath)
+	if err != nil {
+		return err
+	}
+
+	ticketsFile, err := os.Open(*ticketsPath)
+	if err != nil {
+		return fmt.Errorf("unable to open tickets: %w", err)
+	}
+	defer ticketsFile.Close()
+
+	tickets, err := impact.ReadTickets(ticketsFile)
+	if err != nil {
+		return err //nolint:wrapcheck // already annotated
+	}
+
+	report := impact.Analyze(oldCalendar, newCalendar, tickets)
+
+	if *jsonOutput {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(report) //nolint:wrapcheck // written to the caller's writer
+	}
+
+	return writeImpact(stdout, newCalendar, report)
+}
+
+func loadCalendar(path string) (*calendar.Calendar, error) {
+	configFile, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open calendar config: %w", err)
+	}
+	defer configFile.Close()
+
+	config, err := registry.LoadConfig(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	calendarLoaded, err := calendar.NewCalendar(config)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return calendarLoaded, nil
+}
+
+func writeImpact(writer io.Writer, newCalendar *calendar.Calendar, report impact.Report) error {
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0) //nolint:gomnd // padding
+
+	fmt.Fprintln(table, "id\tsubmitted\told due\tnew due\tshift\tworking shift\tnote")
+
+	for _, change := range report.Changes {
+		shift, workingShift := "-", "-"
+		if change.OldError == "" && change.NewError == "" {
+			shift, workingShift = change.Shift.String(), newCalendar.FormatWorkingDuration(change.WorkingShift)
+		}
+
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%s\t%s%s\n",
+			change.ID, newCalendar.FormatTime(change.SubmitAt),
+			formatDueAt(newCalendar, change.OldDueAt), formatDueAt(newCalendar, change.NewDueAt),
+			shift, workingShift, change.OldError, change.NewError,
+		)
+	}
+
+	if err := table.Flush(); err != nil {
+		return err //nolint:wrapcheck // written to the caller's writer
+	}
+
+	_, err := fmt.Fprintf(writer, "%d of %d due dates changed\n", len(report.Changes), report.Tickets)
+
+	return err //nolint:wrapcheck // written to the caller's writer
+}
+
+// formatDueAt returns "invalid" for the zero due date of an error.
+func formatDueAt(calendarNew *calendar.Calendar, dueAt time.Time) string {
+	if dueAt.IsZero() {
+		return "invalid"
+	}
+
+	return calendarNew.FormatTime(dueAt)
+}
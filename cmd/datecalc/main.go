@@ -0,0 +1,70 @@
+// The datecalc command runs the calendar calculations from the command line.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/registry"
+)
+
+var ErrUsage = errors.New("usage")
+
+// command runs a subcommand with its arguments.
+type command func(args []string, calendars *registry.Registry, stdout io.Writer) error
+
+func commands() map[string]command {
+	return map[string]command{
+		"cal":    runCal,
+		"csv":    runCSV,
+		"due":    runDue,
+		"impact": runImpact,
+		"verify": runVerify,
+	}
+}
+
+func main() {
+	locale, _ := calendar.ParseLocale(os.Getenv("LANG"))
+
+	os.Exit(run(os.Args[1:], locale, os.Stdout, os.Stderr))
+}
+
+// run prints the errors in the language of locale.
+func run(args []string, locale calendar.Locale, stdout, stderr io.Writer) int {
+	names := make([]string, 0, len(commands()))
+	for name := range commands() {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	if len(args) == 0 || commands()[args[0]] == nil {
+		fmt.Fprintf(stderr, "usage: datecalc %s [flags]\n", strings.Join(names, "|"))
+
+		return 2 //nolint:gomnd // exit code of usage errors
+	}
+
+	calendars, err := registry.Builtin()
+	if err != nil {
+		fmt.Fprintf(stderr, "unable to init calendars: %s\n", err)
+
+		return 1
+	}
+
+	if err := commands()[args[0]](args[1:], calendars, stdout); err != nil {
+		fmt.Fprintf(stderr, "%s: %s\n", args[0], locale.Message(err))
+
+		if errors.Is(err, ErrUsage) {
+			return 2 //nolint:gomnd // exit code of usage errors
+		}
+
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/stretchr/testify/suite"
+)
+
+type DatecalcTestSuite struct {
+	suite.Suite
+}
+
+func TestDatecalcTestSuite(t *testing.T) {
+	suite.Run(t, new(DatecalcTestSuite))
+}
+
+func (s *DatecalcTestSuite) testCalendar() *calendar.Calendar {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().NoError(err)
+
+	return calendarTest
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/registry"
+)
+
+var ErrMismatch = errors.New("due date mismatch")
+
+// verifyReport is the result of the submit times between -from and -to.
+type verifyReport struct {
+	checked    int
+	skipped    int
+	mismatches int
+}
+
+// runVerify compares the due dates of CalculateDueDate and ReferenceDueDate for the submit times
+// from -from to -to by -step, and prints the mismatches. The submit times out of the working hours are skipped.
+func runVerify(args []string, calendars *registry.Registry, stdout io.Writer) error {
+	flags := flag.NewFlagSet("verify", flag.ContinueOnError)
+	calendarName := flags.String("calendar", registry.DefaultName, "calendar name")
+	configPath := flags.String("config", "", "calendar config file (JSON), instead of -calendar")
+	from := flags.String("from", "", "first submit time in the time format of the calendar")
+	to := flags.String("to", "", "last submit time in the time format of the calendar")
+	step := flags.Duration("step", 15*time.Minute, "step of the submit times") //nolint:gomnd // default step
+	hours := flags.Float64("hours", 16, "turnaround in working hours")         //nolint:gomnd // default turnaround
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w: %s", ErrUsage, err.Error())
+	}
+
+	if *step <= 0 {
+		return fmt.Errorf("%w: -step must be positive", ErrUsage)
+	}
+
+	if err := calendar.ValidateTurnaroundHours(*hours); err != nil {
+		return fmt.Errorf("%w: invalid -hours: %s", ErrUsage, err.Error())
+	}
+
+	calendarNamed, err := verifyCalendar(calendars, *calendarName, *configPath)
+	if err != nil {
+		return err
+	}
+
+	fromAt, err := calendarNamed.ParseTime(*from)
+	if err != nil {
+		return fmt.Errorf("%w: invalid -from: %s", ErrUsage, err.Error())
+	}
+
+	toAt, err := calendarNamed.ParseTime(*to)
+	if err != nil {
+		return fmt.Errorf("%w: invalid -to: %s", ErrUsage, err.Error())
+	}
+
+	table := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0) //nolint:gomnd // padding
+	report := verifyReport{checked: 0, skipped: 0, mismatches: 0}
+
+	for submitAt := fromAt; !submitAt.After(toAt); submitAt = submitAt.Add(*step) {
+		report.add(table, calendarNamed, submitAt, *hours)
+	}
+
+	fmt.Fprintf(table, "checked %d submit times, skipped %d out of working hours, %d mismatches\n",
+		report.checked, report.skipped, report.mismatches,
+	)
+
+	if err := table.Flush(); err != nil {
+		return err //nolint:wrapcheck // written to the caller's writer
+	}
+
+	if report.mismatches > 0 {
+		return fmt.Errorf("%w: %d of %d", ErrMismatch, report.mismatches, report.checked)
+	}
+
+	return nil
+}
+
+func verifyCalendar(calendars *registry.Registry, name, configPath string) (*calendar.Calendar, error) {
+	if configPath != "" {
+		return loadCalendar(configPath)
+	}
+
+	return calendars.Calendar(name) //nolint:wrapcheck // already annotated
+}
+
+// add compares the due dates of the submit time, and prints them, if they mismatch.
+// The due dates match, if there is no working time between them.
+func (report *verifyReport) add(writer io.Writer, calendarNamed *calendar.Calendar, submitAt time.Time, hours float64) {
+	dueAt, err := calendarNamed.CalculateDueDate(submitAt, hours)
+	referenceDueAt, referenceErr := calendarNamed.ReferenceDueDate(submitAt, hours)
+
+	switch {
+	case err != nil && referenceErr != nil:
+		report.skipped++
+	case err != nil || referenceErr != nil:
+		report.checked++
+		report.mismatches++
+
+		fmt.Fprintf(writer, "submitted %s\terror %v\treference error %v\n",
+			calendarNamed.FormatTime(submitAt), err, referenceErr,
+		)
+	default:
+		report.checked++
+
+		if calendarNamed.ReferenceWorkingDuration(dueAt, referenceDueAt) != 0 {
+			report.mismatches++
+
+			fmt.Fprintf(writer, "submitted %s\tdue %s\treference due %s\n", calendarNamed.FormatTime(submitAt),
+				calendarNamed.FormatTime(dueAt), calendarNamed.FormatTime(referenceDueAt),
+			)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+
+	"github.com/pgillich/date_calculator/pkg/registry"
+)
+
+func (s *DatecalcTestSuite) TestRunVerify() {
+	calendars, err := registry.Builtin()
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name   string
+		args   []string
+		output string
+		err    error
+	}{
+		{
+			name: "Working day",
+			args: []string{
+				"-calendar", "hu", "-from", "2021-10-22T08:00:00+02:00", "-to", "2021-10-22T18:00:00+02:00",
+				"-step", "1h", "-hours", "12.5",
+			},
+			output: "checked 9 submit times, skipped 2 out of working hours, 0 mismatches\n",
+			err:    nil,
+		},
+		{
+			name:   "Negative hours",
+			args:   []string{"-from", "2021-10-22T09:00:00+02:00", "-to", "2021-10-22T10:00:00+02:00", "-hours", "-1"},
+			output: "",
+			err:    ErrUsage,
+		},
+		{
+			name:   "Zero step",
+			args:   []string{"-from", "2021-10-22T09:00:00+02:00", "-to", "2021-10-22T10:00:00+02:00", "-step", "0"},
+			output: "",
+			err:    ErrUsage,
+		},
+		{
+			name:   "Invalid from",
+			args:   []string{"-from", "2021-10-22", "-to", "2021-10-22T10:00:00+02:00"},
+			output: "",
+			err:    ErrUsage,
+		},
+		{
+			name:   "Unknown calendar",
+			args:   []string{"-calendar", "xx", "-from", "2021-10-22T09:00:00+02:00", "-to", "2021-10-22T10:00:00+02:00"},
+			output: "",
+			err:    registry.ErrUnknownCalendar,
+		},
+	}
+
+	for _, test := range tests {
+		output := &bytes.Buffer{}
+		err := runVerify(test.args, calendars, output)
+
+		if test.err != nil {
+			s.Assert().ErrorIs(err, test.err, test.name)
+
+			continue
+		}
+
+		s.Assert().NoError(err, test.name)
+		s.Assert().Equal(test.output, output.String(), test.name)
+	}
+}
@@ -6,4 +6,6 @@ require (
 	github.com/daixiang0/gci v0.2.9 // indirect
 	github.com/stretchr/testify v1.7.0
 	golang.org/x/tools/gopls v0.7.2 // indirect
+	google.golang.org/grpc v1.44.0
+	google.golang.org/protobuf v1.28.0
 )
@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/pgillich/date_calculator/pkg/calendar"
 )
@@ -21,7 +20,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	submitAt, err := time.Parse(time.RFC3339, "2021-10-13T09:30:00+04:00")
+	submitAt, err := calendarTest.ParseTime("2021-10-13T09:30:00+04:00")
 	if err != nil {
 		fmt.Printf("unable to parse datetime: %e\n", err)
 		os.Exit(1)
@@ -37,7 +36,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	fmt.Println(resolvedAt.Format(time.RFC3339))
+	fmt.Println(calendarTest.FormatTime(resolvedAt))
 
 	// Example calling CalculateDueDate as a generated standalone function
 
@@ -49,5 +48,15 @@ func main() {
 		os.Exit(1)
 	}
 
-	fmt.Println(resolvedAt.Format(time.RFC3339))
+	fmt.Println(calendarTest.FormatTime(resolvedAt))
+
+	// Example calling CalculateDueDate by strings in Config.TimeFormat
+
+	resolvedAtString, err := calendarTest.CalculateDueDateString("2021-10-13T09:30:00+04:00", turnaroundDuration)
+	if err != nil {
+		fmt.Printf("unable to calculate issue resolved datetime: %s\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(resolvedAtString)
 }
@@ -0,0 +1,62 @@
+package calendar
+
+import (
+	"sync"
+	"time"
+)
+
+type DueDateRequest struct {
+	SubmitAt               time.Time
+	TurnaroundDurationHour float64
+}
+
+type DueDateResult struct {
+	ResolvedAt time.Time
+	Err        error
+}
+
+// CalculateDueDates calculates the due dates of the requests, the results are in the order of the requests.
+// The requests are split among workers goroutines, if workers is greater than 1.
+func (calendar *Calendar) CalculateDueDates(requests []DueDateRequest, workers int) []DueDateResult {
+	results := make([]DueDateResult, len(requests))
+
+	if workers > len(requests) {
+		workers = len(requests)
+	}
+
+	if workers <= 1 {
+		calendar.calculateDueDates(requests, results)
+
+		return results
+	}
+
+	chunkSize := (len(requests) + workers - 1) / workers
+	waitGroup := sync.WaitGroup{}
+
+	for begin := 0; begin < len(requests); begin += chunkSize {
+		end := begin + chunkSize
+		if end > len(requests) {
+			end = len(requests)
+		}
+
+		waitGroup.Add(1)
+
+		go func(requests []DueDateRequest, results []DueDateResult) {
+			defer waitGroup.Done()
+
+			calendar.calculateDueDates(requests, results)
+		}(requests[begin:end], results[begin:end])
+	}
+
+	waitGroup.Wait()
+
+	return results
+}
+
+func (calendar *Calendar) calculateDueDates(requests []DueDateRequest, results []DueDateResult) {
+	for r, request := range requests {
+		results[r].ResolvedAt, results[r].Err = calendar.CalculateDueDate(
+			request.SubmitAt, request.TurnaroundDurationHour,
+		)
+	}
+}
@@ -3,6 +3,7 @@ package calendar
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -12,6 +13,14 @@ type Config struct {
 	WorkBegins     time.Duration
 	WorkEnds       time.Duration
 	TimeFormat     string
+	// Location is the location of ParseTime and FormatTime. If nil, the times keep their own location.
+	// The working hours must end by the shortest day of the Location, by 23:00 with daylight saving time.
+	Location  *time.Location
+	Holidays  []HolidayRule
+	Overrides []DayOverride
+	// IndexFromYear and IndexToYear (inclusive) enable a precomputed working-time index for the years.
+	IndexFromYear int
+	IndexToYear   int
 
 	dailyWorkDuration time.Duration
 }
@@ -20,6 +29,11 @@ type AdjustableWorkTime struct {
 	config Config
 	time   time.Time
 	adjust time.Duration
+	index  *workIndex
+	trace  *Trace
+	err    error
+	// locale is the language of the notes of the trace
+	locale Locale
 }
 
 const (
@@ -31,6 +45,9 @@ const (
 	WorkBeginsDefault     = 9 * time.Hour
 	WorkEndsDefault       = 17 * time.Hour
 	TimeFormatDefault     = time.RFC3339
+
+	// MaxIndexYears is the most years of the precomputed working-time index.
+	MaxIndexYears = 200
 )
 
 var (
@@ -38,10 +55,16 @@ var (
 	ErrInvalidWorkTime   = errors.New("invalid work datetime")
 	ErrInvalidSubmitTime = errors.New("invalid submit datetime")
 	ErrInvalidTimeFormat = errors.New("invalid time format")
+	ErrInvalidHoliday    = errors.New("invalid holiday rule")
+	ErrInvalidOverride   = errors.New("invalid day override")
+	ErrInvalidIndexYears = errors.New("invalid index years")
+	ErrInvalidTimeString = errors.New("invalid time string")
+	ErrInvalidTurnaround = errors.New("invalid turnaround")
 )
 
 type Calendar struct {
 	config Config
+	index  *workIndex
 }
 
 func NewCalendar(config Config) (*Calendar, error) {
@@ -81,15 +104,43 @@ func NewCalendar(config Config) (*Calendar, error) {
 		)
 	}
 
+	// the slices are copied, so the caller can't change the rules of the calendar
+	config.Holidays = append([]HolidayRule(nil), config.Holidays...)
+	config.Overrides = append([]DayOverride(nil), config.Overrides...)
+
+	if err := validateHolidays(config.Holidays); err != nil {
+		return nil, err
+	}
+
+	if err := validateOverrides(config.Overrides); err != nil {
+		return nil, err
+	}
+
+	if err := validateDayLength(config); err != nil {
+		return nil, err
+	}
+
+	if config.IndexFromYear > config.IndexToYear || (config.IndexFromYear == 0) != (config.IndexToYear == 0) ||
+		config.IndexToYear-config.IndexFromYear >= MaxIndexYears {
+		return nil, fmt.Errorf(
+			"%w: %d - %d", ErrInvalidIndexYears, config.IndexFromYear, config.IndexToYear,
+		)
+	}
+
 	config.dailyWorkDuration = config.WorkEnds - config.WorkBegins
 
 	return &Calendar{
 		config: config,
+		index:  newWorkIndex(config),
 	}, nil
 }
 
 func (calendar *Calendar) CalculateDueDate(submitAt time.Time, turnaroundDurationHour float64) (time.Time, error) {
-	return calendar.calculateDueDate(submitAt, time.Duration(turnaroundDurationHour*float64(time.Hour)))
+	if err := ValidateTurnaroundHours(turnaroundDurationHour); err != nil {
+		return time.Time{}, err
+	}
+
+	return calendar.calculateDueDate(submitAt, HourToDuration(turnaroundDurationHour))
 }
 
 func (calendar *Calendar) CalculateDueDateFunc() func(
@@ -100,38 +151,108 @@ func (calendar *Calendar) CalculateDueDateFunc() func(
 	}
 }
 
-func (calendar *Calendar) calculateDueDate(submitAt time.Time, duration time.Duration) (time.Time, error) {
-	todayBeginsAt := calculateDayTime(submitAt, calendar.config.WorkBegins)
-	todayEndsAt := calculateDayTime(submitAt, calendar.config.WorkEnds)
-
-	if submitAt.Weekday() < calendar.config.FirstWorkday ||
-		submitAt.Weekday() >= calendar.config.FirstWorkday+time.Weekday(calendar.config.WorkdaysInWeek) {
-		return time.Time{}, fmt.Errorf(
-			"%w: %s, must be %s - %s",
-			ErrInvalidSubmitTime,
-			calendar.formatTime(submitAt),
-			calendar.config.FirstWorkday.String(),
-			(calendar.config.FirstWorkday + time.Weekday(calendar.config.WorkdaysInWeek) - 1).String(),
-		)
+// DailyWorkDuration returns the working time of a regular working day.
+func (calendar *Calendar) DailyWorkDuration() time.Duration {
+	return calendar.config.dailyWorkDuration
+}
+
+// MaxWorkingDuration is the longest working time, see WorkingDurationBetween.
+const MaxWorkingDuration = time.Duration(math.MaxInt64)
+
+// WorkingDurationBetween returns the working time between from and to. It's negative, if to is before from.
+// A longer working time than MaxWorkingDuration is returned as MaxWorkingDuration (or -MaxWorkingDuration).
+func (calendar *Calendar) WorkingDurationBetween(from, to time.Time) time.Duration {
+	if to.Before(from) {
+		return -calendar.index.workDurationSaturated(to, from)
 	}
 
-	if submitAt.Before(todayBeginsAt) || submitAt.After(todayEndsAt) {
-		return time.Time{}, fmt.Errorf(
-			"%w: %s, must be %s - %s",
-			ErrInvalidSubmitTime,
-			calendar.formatTime(submitAt),
-			calendar.formatTime(todayBeginsAt),
-			calendar.formatTime(todayEndsAt),
-		)
+	return calendar.index.workDurationSaturated(from, to)
+}
+
+// SubtractWorkingDuration returns the time, having duration working time till at.
+// If it's the end of a working day, the end is returned instead of the beginning of the next working day.
+func (calendar *Calendar) SubtractWorkingDuration(at time.Time, duration time.Duration) time.Time {
+	if duration <= 0 {
+		return at
+	}
+
+	return calendar.index.workTimeBefore(at, duration)
+}
+
+// IsWorkingTime reports whether at is in the working hours, excluding the end of the working hours.
+func (calendar *Calendar) IsWorkingTime(at time.Time) bool {
+	begins, ends, working := calendar.index.workHours(DateOf(at))
+
+	return working && !at.Before(calculateDayTime(at, begins)) && at.Before(calculateDayTime(at, ends))
+}
+
+func (calendar *Calendar) calculateDueDate(submitAt time.Time, duration time.Duration) (time.Time, error) {
+	if err := calendar.validateSubmitTime(submitAt); err != nil {
+		return time.Time{}, err
 	}
 
 	dueCalculator := AdjustableWorkTime{
 		config: calendar.config,
 		time:   submitAt,
 		adjust: duration,
+		index:  calendar.index,
+	}
+
+	return dueCalculator.appendWeeks().appendWorkdayHours().appendToday().dueAt()
+}
+
+func (calendar *Calendar) validateSubmitTime(submitAt time.Time) error {
+	submitTimeError := &SubmitTimeError{
+		SubmitAt:     submitAt,
+		Reason:       "",
+		WindowStart:  time.Time{},
+		WindowEnd:    time.Time{},
+		FirstWorkday: 0,
+		LastWorkday:  0,
+		Holiday:      "",
+		timeFormat:   calendar.config.TimeFormat,
+	}
+
+	override, overridden := calendar.config.override(DateOf(submitAt))
+
+	if overridden && !override.Working {
+		submitTimeError.Reason = ReasonDayOff
+
+		return submitTimeError
+	}
+
+	if !overridden && !calendar.config.isWeeklyWorkday(submitAt.Weekday()) {
+		submitTimeError.Reason = ReasonWeekday
+		submitTimeError.FirstWorkday = calendar.config.FirstWorkday
+		submitTimeError.LastWorkday = calendar.config.FirstWorkday + time.Weekday(calendar.config.WorkdaysInWeek) - 1
+
+		return submitTimeError
+	}
+
+	workBegins, workEnds, _ := calendar.index.workHours(DateOf(submitAt))
+	todayBeginsAt := calculateDayTime(submitAt, workBegins)
+	todayEndsAt := calculateDayTime(submitAt, workEnds)
+
+	if holiday, is := calendar.index.holiday(DateOf(submitAt)); is && !overridden && !holiday.IsPartial() {
+		submitTimeError.Reason = ReasonHoliday
+		submitTimeError.Holiday = holiday.Name
+
+		return submitTimeError
+	}
+
+	if submitAt.Before(todayBeginsAt) || submitAt.After(todayEndsAt) {
+		submitTimeError.Reason = ReasonWorkHours
+		submitTimeError.WindowStart = todayBeginsAt
+		submitTimeError.WindowEnd = todayEndsAt
+
+		return submitTimeError
 	}
 
-	return dueCalculator.appendWeeks().appendWorkdayHours().appendToday().time, nil
+	return nil
+}
+
+func (config *Config) isWeeklyWorkday(weekday time.Weekday) bool {
+	return weekday >= config.FirstWorkday && weekday < config.FirstWorkday+time.Weekday(config.WorkdaysInWeek)
 }
 
 func calculateDayTime(today time.Time, fromMidnight time.Duration) time.Time {
@@ -144,71 +265,260 @@ func calculateDayTime(today time.Time, fromMidnight time.Duration) time.Time {
 	).Add(fromMidnight)
 }
 
+// addDays returns the time of the day of at, days later. The time of the day is measured from midnight,
+// so it's the same working time on days of daylight saving time changes, too.
+func addDays(at time.Time, days int) time.Time {
+	date := DateOf(at)
+
+	return date.AddDays(days).In(at.Location()).Add(at.Sub(date.In(at.Location())))
+}
+
 func (workTime *AdjustableWorkTime) appendWeeks() *AdjustableWorkTime {
-	if workTime.adjust == 0 {
+	if workTime.adjust == 0 || workTime.err != nil {
 		return workTime
 	}
 
 	durationWeek := time.Duration(workTime.config.WorkdaysInWeek) * workTime.config.dailyWorkDuration
+
+	if len(workTime.config.Holidays) > 0 || len(workTime.config.Overrides) > 0 {
+		// a week having a holiday or an override may be shorter or longer than durationWeek
+		index := workTime.workIndex()
+		weeks, err := index.weeksWithin(workTime.time, workTime.adjust)
+		if err != nil {
+			workTime.err = err
+
+			return workTime
+		}
+
+		for ; weeks > 0; weeks-- {
+			weeksAt := addDays(workTime.time, daysPerWeek*weeks)
+
+			if index.isWorkTime(weeksAt) {
+				workTime.appendTrace(
+					TraceWeeks, weeksAt, index.workDuration(workTime.time, weeksAt), workTime.locale.weeksNote(weeks),
+				)
+
+				break
+			}
+		}
+
+		for {
+			nextWeekAt := addDays(workTime.time, daysPerWeek)
+			durationThisWeek := index.workDuration(workTime.time, nextWeekAt)
+
+			if workTime.adjust < durationThisWeek || !index.isWorkTime(nextWeekAt) {
+				return workTime
+			}
+
+			workTime.appendTrace(TraceWeeks, nextWeekAt, durationThisWeek, workTime.locale.weeksNote(1))
+		}
+	}
+
 	weeks := int(workTime.adjust / durationWeek)
 	adjustRemained := workTime.adjust % durationWeek
 
-	workTime.time = workTime.time.Add(time.Duration(hoursPerDay*daysPerWeek*weeks) * time.Hour)
-	workTime.adjust = adjustRemained
+	if weeks > 0 {
+		workTime.appendTrace(
+			TraceWeeks,
+			addDays(workTime.time, daysPerWeek*weeks),
+			workTime.adjust-adjustRemained,
+			workTime.locale.weeksNote(weeks),
+		)
+	}
 
 	return workTime
 }
 
+// workIndex returns the index of the Calendar, or a new one, if AdjustableWorkTime was created without it.
+func (workTime *AdjustableWorkTime) workIndex() *workIndex {
+	if workTime.index == nil {
+		workTime.index = newWorkIndex(workTime.config)
+	}
+
+	return workTime.index
+}
+
 func (workTime *AdjustableWorkTime) appendWorkdayHours() *AdjustableWorkTime {
-	if workTime.adjust == 0 {
+	if workTime.adjust == 0 || workTime.err != nil {
 		return workTime
 	}
 
 	workTime.appendWeeks()
 
-	lastWorkday := time.Weekday(int(workTime.config.FirstWorkday) + workTime.config.WorkdaysInWeek - 1)
+	for workTime.err == nil && workTime.adjust >= workTime.config.dailyWorkDuration {
+		nextWorkdayAt, regular, err := workTime.nextWorkday()
+		if err != nil {
+			workTime.err = err
 
-	for workTime.adjust >= workTime.config.dailyWorkDuration {
-		if workTime.time.Weekday() == lastWorkday {
-			weekendAdd := time.Duration((daysPerWeek-workTime.config.WorkdaysInWeek+1)*hoursPerDay) * time.Hour
-			workTime.time = workTime.time.Add(weekendAdd)
-			workTime.adjust -= workTime.config.dailyWorkDuration
-		} else {
-			workTime.time = workTime.time.Add(hoursPerDay * time.Hour)
-			workTime.adjust -= workTime.config.dailyWorkDuration
+			break
 		}
+
+		if !regular {
+			// a day having own working hours is calculated by appendToday
+			break
+		}
+
+		workTime.appendTrace(TraceWorkday, nextWorkdayAt, workTime.config.dailyWorkDuration, "")
 	}
 
 	return workTime
 }
 
+// nextWorkday returns the same time on the next working day
+// and whether both days have the working hours of the Config.
+func (workTime *AdjustableWorkTime) nextWorkday() (time.Time, bool, error) {
+	nextWorkday, err := workTime.workIndex().nextWorkDate(DateOf(workTime.time))
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	nextWorkdayAt := addDays(workTime.time, DateOf(workTime.time).daysUntil(nextWorkday))
+	begins, ends, _ := workTime.workIndex().workHours(nextWorkday)
+	todayBegins, todayEnds, _ := workTime.workIndex().workHours(DateOf(workTime.time))
+
+	return nextWorkdayAt, begins == workTime.config.WorkBegins && ends == workTime.config.WorkEnds &&
+		todayBegins == workTime.config.WorkBegins && todayEnds == workTime.config.WorkEnds, nil
+}
+
 func (workTime *AdjustableWorkTime) appendToday() *AdjustableWorkTime {
-	if workTime.adjust == 0 {
+	if workTime.adjust == 0 || workTime.err != nil {
 		return workTime
 	}
 
 	workTime.appendWorkdayHours()
 
-	todayEndsAt := calculateDayTime(workTime.time, workTime.config.WorkEnds)
-	todayWorkDurationMax := todayEndsAt.Sub(workTime.time)
+	for workTime.err == nil {
+		today := DateOf(workTime.time)
 
-	if workTime.adjust >= todayWorkDurationMax {
-		workTime.adjust += workTime.config.dailyWorkDuration
-		workTime.appendWorkdayHours()
+		begins, ends, working := workTime.workIndex().workHours(today)
+		if !working {
+			nextWorkday, err := workTime.workIndex().nextWorkDate(today)
+			if err != nil {
+				workTime.err = err
 
-		workTime.adjust -= workTime.config.dailyWorkDuration
-	}
+				break
+			}
 
-	workTime.time = workTime.time.Add(workTime.adjust)
-	workTime.adjust = 0
+			workTime.traceSkippedDays(today, nextWorkday)
+			workTime.time = nextWorkday.In(workTime.time.Location())
+
+			continue
+		}
+
+		todayBeginsAt := calculateDayTime(workTime.time, begins)
+		todayEndsAt := calculateDayTime(workTime.time, ends)
+
+		if workTime.time.Before(todayBeginsAt) {
+			workTime.time = todayBeginsAt
+		}
+
+		todayWorkDurationMax := todayEndsAt.Sub(workTime.time)
+
+		if workTime.adjust < todayWorkDurationMax {
+			workTime.appendTrace(TraceSegment, workTime.time.Add(workTime.adjust), workTime.adjust, "")
+
+			break
+		}
+
+		if todayWorkDurationMax > 0 {
+			workTime.appendTrace(TraceSegment, todayEndsAt, todayWorkDurationMax, "")
+		}
+
+		workTime.time = today.AddDays(1).In(workTime.time.Location())
+	}
 
 	return workTime
 }
 
+// dueAt returns the adjusted time, or the error, which stopped the adjustment.
+func (workTime *AdjustableWorkTime) dueAt() (time.Time, error) {
+	if workTime.err != nil {
+		return time.Time{}, workTime.err
+	}
+
+	return workTime.time, nil
+}
+
 func (calendar *Calendar) formatTime(at time.Time) string {
 	return at.Format(calendar.config.TimeFormat)
 }
 
+// ParseTime parses the value by Config.TimeFormat and converts it to Config.Location.
+// A value without time zone is in Config.Location (UTC, if nil).
+func (calendar *Calendar) ParseTime(value string) (time.Time, error) {
+	location := calendar.config.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	parsed, err := time.ParseInLocation(calendar.config.TimeFormat, value, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrInvalidTimeString, err.Error())
+	}
+
+	if calendar.config.Location != nil {
+		parsed = parsed.In(calendar.config.Location)
+	}
+
+	return parsed, nil
+}
+
+// FormatTime formats the time by Config.TimeFormat in Config.Location.
+func (calendar *Calendar) FormatTime(at time.Time) string {
+	if calendar.config.Location != nil {
+		at = at.In(calendar.config.Location)
+	}
+
+	return calendar.formatTime(at)
+}
+
+// CalculateDueDateString is CalculateDueDate, parsing and formatting the times by ParseTime and FormatTime.
+func (calendar *Calendar) CalculateDueDateString(submitAt string, turnaroundDurationHour float64) (string, error) {
+	submitAtParsed, err := calendar.ParseTime(submitAt)
+	if err != nil {
+		return "", err
+	}
+
+	dueAt, err := calendar.CalculateDueDate(submitAtParsed, turnaroundDurationHour)
+	if err != nil {
+		return "", err
+	}
+
+	return calendar.FormatTime(dueAt), nil
+}
+
+// ValidateTurnaroundHours checks that the turnaround is a non-negative number, which fits into time.Duration.
+func ValidateTurnaroundHours(hours float64) error {
+	// rounded like HourToDuration, NaN is rejected by the comparison
+	if hours < 0 || !(math.Round(hours*float64(time.Hour)) < math.MaxInt64) {
+		return fmt.Errorf("%w: %v hours", ErrInvalidTurnaround, hours)
+	}
+
+	return nil
+}
+
+// ValidateWorkingDuration checks that the working duration, returned by WorkingDurationBetween, is not saturated.
+func ValidateWorkingDuration(duration time.Duration) error {
+	if duration == MaxWorkingDuration || duration == -MaxWorkingDuration {
+		return fmt.Errorf("%w: longer than %s", ErrInvalidWorkingDuration, MaxWorkingDuration)
+	}
+
+	return nil
+}
+
+// addUnits returns duration increased by amount (not negative) of unit, or false, if it overflows time.Duration.
+func addUnits(duration time.Duration, amount float64, unit time.Duration) (time.Duration, bool) {
+	units := amount * float64(unit)
+	if !(units < math.MaxInt64) {
+		return 0, false
+	}
+
+	sum := duration + time.Duration(units)
+
+	return sum, sum >= duration
+}
+
+// HourToDuration converts hours to duration, rounded to nanosecond, so whole minutes (for example 1/60) are exact.
 func HourToDuration(hour float64) time.Duration {
-	return time.Duration(hour * float64(time.Hour))
+	return time.Duration(math.Round(hour * float64(time.Hour)))
 }
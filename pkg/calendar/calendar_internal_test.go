@@ -3,6 +3,7 @@ package calendar
 import (
 	"testing"
 	"time"
+	_ "time/tzdata" // the zones of the daylight saving time are needed without system tzdata
 
 	"github.com/stretchr/testify/suite"
 )
@@ -22,6 +23,9 @@ func TestCalendarTestSuite(t *testing.T) {
 }
 
 func (s *CalendarTestSuite) TestNewCalendar() {
+	budapest, err := time.LoadLocation("Europe/Budapest")
+	s.Require().NoError(err)
+
 	testCases := []struct {
 		name string
 
@@ -135,6 +139,255 @@ func (s *CalendarTestSuite) TestNewCalendar() {
 			expectedCreated: false,
 			expectedErr:     ErrInvalidWorkTime,
 		},
+		{
+			name: "Override with default hours",
+			config: Config{
+				FirstWorkday:   time.Monday,
+				WorkdaysInWeek: 5,
+				WorkBegins:     9 * time.Hour,
+				WorkEnds:       17 * time.Hour,
+				TimeFormat:     TimeFormatDefault,
+				Overrides: []DayOverride{
+					{Date: NewDate(2021, time.December, 11), Working: true},
+					{Date: NewDate(2021, time.December, 24), Working: false},
+				},
+				dailyWorkDuration: (17 - 9) * time.Hour,
+			},
+			expectedCreated: true,
+			expectedErr:     nil,
+		},
+		{
+			name: "Duplicated override",
+			config: Config{
+				FirstWorkday:   time.Monday,
+				WorkdaysInWeek: 5,
+				WorkBegins:     9 * time.Hour,
+				WorkEnds:       17 * time.Hour,
+				TimeFormat:     TimeFormatDefault,
+				Overrides: []DayOverride{
+					{Date: NewDate(2021, time.December, 11), Working: true},
+					{Date: NewDate(2021, time.December, 11), Working: false},
+				},
+				dailyWorkDuration: (17 - 9) * time.Hour,
+			},
+			expectedCreated: false,
+			expectedErr:     ErrInvalidOverride,
+		},
+		{
+			name: "Bigger override WorkBegins",
+			config: Config{
+				FirstWorkday:   time.Monday,
+				WorkdaysInWeek: 5,
+				WorkBegins:     9 * time.Hour,
+				WorkEnds:       17 * time.Hour,
+				TimeFormat:     TimeFormatDefault,
+				Overrides: []DayOverride{
+					{Date: NewDate(2021, time.December, 11), Working: true, WorkBegins: 13 * time.Hour, WorkEnds: 9 * time.Hour},
+				},
+				dailyWorkDuration: (17 - 9) * time.Hour,
+			},
+			expectedCreated: false,
+			expectedErr:     ErrInvalidOverride,
+		},
+		{
+			name: "Reversed partial holiday hours",
+			config: Config{
+				FirstWorkday:   time.Monday,
+				WorkdaysInWeek: 5,
+				WorkBegins:     9 * time.Hour,
+				WorkEnds:       17 * time.Hour,
+				TimeFormat:     TimeFormatDefault,
+				Holidays: []HolidayRule{PartialHoliday{
+					Rule:       FixedHoliday{Name: "Christmas Eve", Month: time.December, Day: 24},
+					WorkBegins: 13 * time.Hour,
+					WorkEnds:   9 * time.Hour,
+				}},
+				dailyWorkDuration: (17 - 9) * time.Hour,
+			},
+			expectedCreated: false,
+			expectedErr:     ErrInvalidHoliday,
+		},
+		{
+			name: "Wrapped reversed partial holiday hours",
+			config: Config{
+				FirstWorkday:   time.Monday,
+				WorkdaysInWeek: 5,
+				WorkBegins:     9 * time.Hour,
+				WorkEnds:       17 * time.Hour,
+				TimeFormat:     TimeFormatDefault,
+				Holidays: []HolidayRule{HolidayYears{
+					Rule: ObservedHoliday{Rule: PartialHoliday{
+						Rule:       FixedHoliday{Name: "Christmas Eve", Month: time.December, Day: 24},
+						WorkBegins: 13 * time.Hour,
+						WorkEnds:   13 * time.Hour,
+					}},
+					From: 2021,
+					To:   2022,
+				}},
+				dailyWorkDuration: (17 - 9) * time.Hour,
+			},
+			expectedCreated: false,
+			expectedErr:     ErrInvalidHoliday,
+		},
+		{
+			name: "Wrapped partial holiday",
+			config: Config{
+				FirstWorkday:   time.Monday,
+				WorkdaysInWeek: 5,
+				WorkBegins:     9 * time.Hour,
+				WorkEnds:       17 * time.Hour,
+				TimeFormat:     TimeFormatDefault,
+				Holidays: []HolidayRule{HolidayYears{
+					Rule: PartialHoliday{
+						Rule:       FixedHoliday{Name: "Christmas Eve", Month: time.December, Day: 24},
+						WorkBegins: 9 * time.Hour,
+						WorkEnds:   13 * time.Hour,
+					},
+					From: 2021,
+					To:   2022,
+				}},
+				dailyWorkDuration: (17 - 9) * time.Hour,
+			},
+			expectedCreated: true,
+			expectedErr:     nil,
+		},
+		{
+			name: "Index years",
+			config: Config{
+				FirstWorkday:      time.Monday,
+				WorkdaysInWeek:    5,
+				WorkBegins:        9 * time.Hour,
+				WorkEnds:          17 * time.Hour,
+				TimeFormat:        TimeFormatDefault,
+				IndexFromYear:     2020,
+				IndexToYear:       2030,
+				dailyWorkDuration: (17 - 9) * time.Hour,
+			},
+			expectedCreated: true,
+			expectedErr:     nil,
+		},
+		{
+			name: "Reversed index years",
+			config: Config{
+				FirstWorkday:      time.Monday,
+				WorkdaysInWeek:    5,
+				WorkBegins:        9 * time.Hour,
+				WorkEnds:          17 * time.Hour,
+				TimeFormat:        TimeFormatDefault,
+				IndexFromYear:     2030,
+				IndexToYear:       2020,
+				dailyWorkDuration: (17 - 9) * time.Hour,
+			},
+			expectedCreated: false,
+			expectedErr:     ErrInvalidIndexYears,
+		},
+		{
+			name: "Too many index years",
+			config: Config{
+				FirstWorkday:      time.Monday,
+				WorkdaysInWeek:    5,
+				WorkBegins:        9 * time.Hour,
+				WorkEnds:          17 * time.Hour,
+				TimeFormat:        TimeFormatDefault,
+				IndexFromYear:     1,
+				IndexToYear:       9999,
+				dailyWorkDuration: (17 - 9) * time.Hour,
+			},
+			expectedCreated: false,
+			expectedErr:     ErrInvalidIndexYears,
+		},
+		{
+			name: "Whole day without daylight saving time",
+			config: Config{
+				FirstWorkday:      time.Monday,
+				WorkdaysInWeek:    5,
+				WorkBegins:        0,
+				WorkEnds:          24 * time.Hour,
+				TimeFormat:        TimeFormatDefault,
+				Location:          time.FixedZone("+02:00", 2*60*60),
+				dailyWorkDuration: 24 * time.Hour,
+			},
+			expectedCreated: true,
+			expectedErr:     nil,
+		},
+		{
+			name: "Till the shortest day",
+			config: Config{
+				FirstWorkday:      time.Monday,
+				WorkdaysInWeek:    5,
+				WorkBegins:        0,
+				WorkEnds:          23 * time.Hour,
+				TimeFormat:        TimeFormatDefault,
+				Location:          budapest,
+				dailyWorkDuration: 23 * time.Hour,
+			},
+			expectedCreated: true,
+			expectedErr:     nil,
+		},
+		{
+			name: "After the shortest day",
+			config: Config{
+				FirstWorkday:      time.Monday,
+				WorkdaysInWeek:    5,
+				WorkBegins:        0,
+				WorkEnds:          24 * time.Hour,
+				TimeFormat:        TimeFormatDefault,
+				Location:          budapest,
+				dailyWorkDuration: 24 * time.Hour,
+			},
+			expectedCreated: false,
+			expectedErr:     ErrInvalidWorkTime,
+		},
+		{
+			name: "Override after the shortest day",
+			config: Config{
+				FirstWorkday:   time.Monday,
+				WorkdaysInWeek: 5,
+				WorkBegins:     9 * time.Hour,
+				WorkEnds:       17 * time.Hour,
+				TimeFormat:     TimeFormatDefault,
+				Location:       budapest,
+				Overrides: []DayOverride{{
+					Date: NewDate(2021, time.March, 28), Working: true, WorkBegins: 9 * time.Hour, WorkEnds: 24 * time.Hour,
+				}},
+				dailyWorkDuration: (17 - 9) * time.Hour,
+			},
+			expectedCreated: false,
+			expectedErr:     ErrInvalidWorkTime,
+		},
+		{
+			name: "Wrapped partial holiday after the shortest day",
+			config: Config{
+				FirstWorkday:   time.Monday,
+				WorkdaysInWeek: 5,
+				WorkBegins:     9 * time.Hour,
+				WorkEnds:       17 * time.Hour,
+				TimeFormat:     TimeFormatDefault,
+				Location:       budapest,
+				Holidays: []HolidayRule{HolidayYears{Rule: PartialHoliday{
+					Rule:       FixedHoliday{Name: "Long day", Month: time.March, Day: 28},
+					WorkBegins: 9 * time.Hour,
+					WorkEnds:   23*time.Hour + 30*time.Minute,
+				}}},
+				dailyWorkDuration: (17 - 9) * time.Hour,
+			},
+			expectedCreated: false,
+			expectedErr:     ErrInvalidWorkTime,
+		},
+		{
+			name: "Missing index year",
+			config: Config{
+				FirstWorkday:      time.Monday,
+				WorkdaysInWeek:    5,
+				WorkBegins:        9 * time.Hour,
+				WorkEnds:          17 * time.Hour,
+				TimeFormat:        TimeFormatDefault,
+				IndexToYear:       2020,
+				dailyWorkDuration: (17 - 9) * time.Hour,
+			},
+			expectedCreated: false,
+			expectedErr:     ErrInvalidIndexYears,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -439,3 +692,288 @@ func (s *AdjustableWorkTimeTestSuite) TestAppends() {
 		})
 	}
 }
+
+type appendsTestCase struct {
+	name string
+
+	submitAt           time.Time
+	turnaroundDuration time.Duration
+
+	expectedAppendWeeks        AdjustableWorkTime
+	expectedAppendWorkdayHours AdjustableWorkTime
+	expectedAppendToday        AdjustableWorkTime
+}
+
+func (s *AdjustableWorkTimeTestSuite) runAppends(config Config, testCases []appendsTestCase) {
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			adjustedWorkTime := &AdjustableWorkTime{
+				config: config,
+				time:   testCase.submitAt,
+				adjust: testCase.turnaroundDuration,
+			}
+
+			adjustedWorkTime = adjustedWorkTime.appendWeeks()
+
+			s.Assert().Equal(
+				testCase.expectedAppendWeeks.time.Format(TimeFormatDefault),
+				adjustedWorkTime.time.Format(TimeFormatDefault),
+				"appendWeeks, time",
+			)
+
+			s.Assert().Equal(
+				testCase.expectedAppendWeeks.adjust,
+				adjustedWorkTime.adjust,
+				"appendWeeks, adjust",
+			)
+
+			adjustedWorkTime = adjustedWorkTime.appendWorkdayHours()
+
+			s.Assert().Equal(
+				testCase.expectedAppendWorkdayHours.time.Format(TimeFormatDefault),
+				adjustedWorkTime.time.Format(TimeFormatDefault),
+				"appendWorkdayHours, time",
+			)
+
+			s.Assert().Equal(
+				testCase.expectedAppendWorkdayHours.adjust,
+				adjustedWorkTime.adjust,
+				"appendWorkdayHours, adjust",
+			)
+
+			adjustedWorkTime = adjustedWorkTime.appendToday()
+
+			s.Assert().Equal(
+				testCase.expectedAppendToday.time.Format(TimeFormatDefault),
+				adjustedWorkTime.time.Format(TimeFormatDefault),
+				"appendToday, time",
+			)
+
+			s.Assert().Equal(
+				testCase.expectedAppendToday.adjust,
+				adjustedWorkTime.adjust,
+				"appendToday, adjust",
+			)
+		})
+	}
+}
+
+//nolint:exhaustivestruct // do not check missing private member setting
+func (s *AdjustableWorkTimeTestSuite) TestAppendsWithHolidays() {
+	calendarTest, err := NewCalendar(Config{
+		FirstWorkday:   FirstWorkdayDefault,
+		WorkdaysInWeek: WorkdaysInWeekDefault,
+		WorkBegins:     WorkBeginsDefault,
+		WorkEnds:       WorkEndsDefault,
+		TimeFormat:     TimeFormatDefault,
+		Holidays: []HolidayRule{
+			FixedHoliday{Name: "Saturday holiday", Month: time.October, Day: 23},
+			FixedHoliday{Name: "Monday holiday", Month: time.November, Day: 1},
+		},
+	})
+	s.Assert().NoError(err)
+
+	testCases := []appendsTestCase{
+		{
+			name:               "Short duration over holiday",
+			submitAt:           parseTimeRfc3339("2021-10-29T16:00:00+04:00"),
+			turnaroundDuration: HourToDuration(2.5),
+			expectedAppendWeeks: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-10-29T16:00:00+04:00"),
+				adjust: HourToDuration(2.5),
+			},
+			expectedAppendWorkdayHours: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-10-29T16:00:00+04:00"),
+				adjust: HourToDuration(2.5),
+			},
+			expectedAppendToday: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-11-02T10:30:00+04:00"),
+				adjust: HourToDuration(0),
+			},
+		},
+		{
+			name:               "Holiday in the week",
+			submitAt:           parseTimeRfc3339("2021-10-27T09:30:00+04:00"),
+			turnaroundDuration: HourToDuration(40),
+			expectedAppendWeeks: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-11-03T09:30:00+04:00"),
+				adjust: HourToDuration(8),
+			},
+			expectedAppendWorkdayHours: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-11-04T09:30:00+04:00"),
+				adjust: HourToDuration(0),
+			},
+			expectedAppendToday: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-11-04T09:30:00+04:00"),
+				adjust: HourToDuration(0),
+			},
+		},
+		{
+			name:               "Weekend holiday in the week",
+			submitAt:           parseTimeRfc3339("2021-10-20T09:30:00+04:00"),
+			turnaroundDuration: HourToDuration(44),
+			expectedAppendWeeks: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-10-27T09:30:00+04:00"),
+				adjust: HourToDuration(4),
+			},
+			expectedAppendWorkdayHours: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-10-27T09:30:00+04:00"),
+				adjust: HourToDuration(4),
+			},
+			expectedAppendToday: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-10-27T13:30:00+04:00"),
+				adjust: HourToDuration(0),
+			},
+		},
+	}
+
+	s.runAppends(calendarTest.config, testCases)
+}
+
+//nolint:exhaustivestruct // do not check missing private member setting
+func (s *AdjustableWorkTimeTestSuite) TestAppendsWithOverrides() {
+	calendarTest, err := NewCalendar(Config{
+		FirstWorkday:   FirstWorkdayDefault,
+		WorkdaysInWeek: WorkdaysInWeekDefault,
+		WorkBegins:     WorkBeginsDefault,
+		WorkEnds:       WorkEndsDefault,
+		TimeFormat:     TimeFormatDefault,
+		Overrides: []DayOverride{
+			{Date: NewDate(2021, time.December, 18), Working: true, WorkBegins: 8 * time.Hour, WorkEnds: 12 * time.Hour},
+			{Date: NewDate(2021, time.December, 24), Working: false},
+		},
+	})
+	s.Assert().NoError(err)
+
+	testCases := []appendsTestCase{
+		{
+			name:               "Short duration to own hours",
+			submitAt:           parseTimeRfc3339("2021-12-17T16:00:00+01:00"),
+			turnaroundDuration: HourToDuration(3),
+			expectedAppendWeeks: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-17T16:00:00+01:00"),
+				adjust: HourToDuration(3),
+			},
+			expectedAppendWorkdayHours: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-17T16:00:00+01:00"),
+				adjust: HourToDuration(3),
+			},
+			expectedAppendToday: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-18T10:00:00+01:00"),
+				adjust: HourToDuration(0),
+			},
+		},
+		{
+			name:               "Workdays until own hours",
+			submitAt:           parseTimeRfc3339("2021-12-16T09:30:00+01:00"),
+			turnaroundDuration: HourToDuration(20),
+			expectedAppendWeeks: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-16T09:30:00+01:00"),
+				adjust: HourToDuration(20),
+			},
+			expectedAppendWorkdayHours: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-17T09:30:00+01:00"),
+				adjust: HourToDuration(12),
+			},
+			expectedAppendToday: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-20T09:30:00+01:00"),
+				adjust: HourToDuration(0),
+			},
+		},
+		{
+			name:               "Non-working override in the week",
+			submitAt:           parseTimeRfc3339("2021-12-20T09:30:00+01:00"),
+			turnaroundDuration: HourToDuration(40),
+			expectedAppendWeeks: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-27T09:30:00+01:00"),
+				adjust: HourToDuration(8),
+			},
+			expectedAppendWorkdayHours: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-28T09:30:00+01:00"),
+				adjust: HourToDuration(0),
+			},
+			expectedAppendToday: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-28T09:30:00+01:00"),
+				adjust: HourToDuration(0),
+			},
+		},
+	}
+
+	s.runAppends(calendarTest.config, testCases)
+}
+
+//nolint:exhaustivestruct // do not check missing private member setting
+func (s *AdjustableWorkTimeTestSuite) TestAppendsWithPartialHolidays() {
+	calendarTest, err := NewCalendar(Config{
+		FirstWorkday:   FirstWorkdayDefault,
+		WorkdaysInWeek: WorkdaysInWeekDefault,
+		WorkBegins:     WorkBeginsDefault,
+		WorkEnds:       WorkEndsDefault,
+		TimeFormat:     TimeFormatDefault,
+		Holidays: []HolidayRule{
+			PartialHoliday{
+				Rule:       FixedHoliday{Name: "Christmas Eve", Month: time.December, Day: 24},
+				WorkBegins: 9 * time.Hour,
+				WorkEnds:   13 * time.Hour,
+			},
+		},
+	})
+	s.Assert().NoError(err)
+
+	testCases := []appendsTestCase{
+		{
+			name:               "Shortened day in the week",
+			submitAt:           parseTimeRfc3339("2021-12-20T09:30:00+01:00"),
+			turnaroundDuration: HourToDuration(40),
+			expectedAppendWeeks: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-27T09:30:00+01:00"),
+				adjust: HourToDuration(4),
+			},
+			expectedAppendWorkdayHours: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-27T09:30:00+01:00"),
+				adjust: HourToDuration(4),
+			},
+			expectedAppendToday: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-27T13:30:00+01:00"),
+				adjust: HourToDuration(0),
+			},
+		},
+		{
+			name:               "Regular and shortened weeks",
+			submitAt:           parseTimeRfc3339("2021-12-13T09:30:00+01:00"),
+			turnaroundDuration: HourToDuration(80),
+			expectedAppendWeeks: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-27T09:30:00+01:00"),
+				adjust: HourToDuration(4),
+			},
+			expectedAppendWorkdayHours: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-27T09:30:00+01:00"),
+				adjust: HourToDuration(4),
+			},
+			expectedAppendToday: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-27T13:30:00+01:00"),
+				adjust: HourToDuration(0),
+			},
+		},
+		{
+			name:               "Over shortened day",
+			submitAt:           parseTimeRfc3339("2021-12-23T15:00:00+01:00"),
+			turnaroundDuration: HourToDuration(8),
+			expectedAppendWeeks: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-23T15:00:00+01:00"),
+				adjust: HourToDuration(8),
+			},
+			expectedAppendWorkdayHours: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-23T15:00:00+01:00"),
+				adjust: HourToDuration(8),
+			},
+			expectedAppendToday: AdjustableWorkTime{
+				time:   parseTimeRfc3339("2021-12-27T11:00:00+01:00"),
+				adjust: HourToDuration(0),
+			},
+		},
+	}
+
+	s.runAppends(calendarTest.config, testCases)
+}
@@ -0,0 +1,76 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// Date is a calendar day without time of day and location.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+const dateFormat = "2006-01-02"
+
+// NewDate returns the normalized date, for example October 32 becomes November 1.
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the date of the given time in its own location.
+func DateOf(at time.Time) Date {
+	year, month, day := at.Date()
+
+	return Date{
+		Year:  year,
+		Month: month,
+		Day:   day,
+	}
+}
+
+// ParseDate parses a date in YYYY-MM-DD format.
+func ParseDate(value string) (Date, error) {
+	parsed, err := time.Parse(dateFormat, value)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date: %w", err)
+	}
+
+	return DateOf(parsed), nil
+}
+
+// In returns the midnight of the date in the given location.
+func (date Date) In(location *time.Location) time.Time {
+	return time.Date(date.Year, date.Month, date.Day, 0, 0, 0, 0, location)
+}
+
+func (date Date) Weekday() time.Weekday {
+	return date.In(time.UTC).Weekday()
+}
+
+func (date Date) AddDays(days int) Date {
+	return NewDate(date.Year, date.Month, date.Day+days)
+}
+
+// daysUntil returns the number of days from the date till other, negative, if other is before the date.
+// Unlike time.Time.Sub, it's not limited to 292 years.
+func (date Date) daysUntil(other Date) int {
+	return int((other.In(time.UTC).Unix() - date.In(time.UTC).Unix()) / int64(hoursPerDay*time.Hour/time.Second))
+}
+
+func (date Date) Before(other Date) bool {
+	if date.Year != other.Year {
+		return date.Year < other.Year
+	}
+
+	if date.Month != other.Month {
+		return date.Month < other.Month
+	}
+
+	return date.Day < other.Day
+}
+
+func (date Date) String() string {
+	return date.In(time.UTC).Format(dateFormat)
+}
@@ -0,0 +1,72 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// The years probed for the daylight saving time changes of a location.
+const (
+	dayLengthYearFrom = 1970
+	dayLengthYearTo   = 2100
+)
+
+/*
+validateDayLength rejects the working hours ending after the shortest day of Config.Location.
+The working hours are measured from midnight, so on the day of 23 hours (the beginning of the daylight saving time)
+the working hours ending at 24:00 would end on the next day.
+*/
+func validateDayLength(config Config) error {
+	if config.Location == nil {
+		return nil
+	}
+
+	shortest := shortestDay(config.Location)
+
+	if ends := latestWorkEnds(config); ends > shortest {
+		return fmt.Errorf(
+			"%w: %s is after the shortest day (%s) of %s", ErrInvalidWorkTime, ends.String(), shortest.String(),
+			config.Location.String(),
+		)
+	}
+
+	return nil
+}
+
+// shortestDay returns the length of the shortest day of the location, by its biggest change of the offset forward.
+func shortestDay(location *time.Location) time.Duration {
+	_, previous := time.Date(dayLengthYearFrom, time.January, 1, 0, 0, 0, 0, location).Zone()
+	biggest := 0
+
+	for year := dayLengthYearFrom; year <= dayLengthYearTo; year++ {
+		for month := time.January; month <= time.December; month++ {
+			_, offset := time.Date(year, month, 1, 0, 0, 0, 0, location).Zone()
+			if offset-previous > biggest {
+				biggest = offset - previous
+			}
+
+			previous = offset
+		}
+	}
+
+	return hoursPerDay*time.Hour - time.Duration(biggest)*time.Second
+}
+
+// latestWorkEnds returns the latest end of the regular, the partial holiday and the override working hours.
+func latestWorkEnds(config Config) time.Duration {
+	latest := config.WorkEnds
+
+	for _, rule := range config.Holidays {
+		if ends := partialHolidayEnds(rule); ends > latest {
+			latest = ends
+		}
+	}
+
+	for _, override := range config.Overrides {
+		if override.Working && override.WorkEnds > latest {
+			latest = override.WorkEnds
+		}
+	}
+
+	return latest
+}
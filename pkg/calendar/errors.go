@@ -0,0 +1,43 @@
+package calendar
+
+import (
+	"time"
+)
+
+// SubmitTimeReason is the reason of an invalid submit time.
+type SubmitTimeReason string
+
+const (
+	// ReasonDayOff is a non-working day by an override.
+	ReasonDayOff SubmitTimeReason = "day_off"
+	// ReasonWeekday is a non-working day of the week.
+	ReasonWeekday SubmitTimeReason = "weekday"
+	// ReasonHoliday is a holiday.
+	ReasonHoliday SubmitTimeReason = "holiday"
+	// ReasonWorkHours is out of the working hours of the day.
+	ReasonWorkHours SubmitTimeReason = "work_hours"
+)
+
+// SubmitTimeError is an invalid submit time. It's ErrInvalidSubmitTime by errors.Is.
+// WindowStart and WindowEnd are the working hours of the day, if Reason is ReasonWorkHours.
+// FirstWorkday and LastWorkday are the working days of the week, if Reason is ReasonWeekday.
+// Holiday is the name of the holiday, if Reason is ReasonHoliday.
+type SubmitTimeError struct {
+	SubmitAt     time.Time
+	Reason       SubmitTimeReason
+	WindowStart  time.Time
+	WindowEnd    time.Time
+	FirstWorkday time.Weekday
+	LastWorkday  time.Weekday
+	Holiday      string
+
+	timeFormat string
+}
+
+func (err *SubmitTimeError) Error() string {
+	return LocaleEnglish.submitTimeMessage(err)
+}
+
+func (err *SubmitTimeError) Unwrap() error {
+	return ErrInvalidSubmitTime
+}
@@ -0,0 +1,95 @@
+package calendar
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Holder holds a Calendar, which can be replaced while it's used concurrently.
+// A calculation runs on the Calendar loaded at its beginning, so in-flight calculations finish on the old one.
+type Holder struct {
+	value atomic.Value
+	mutex sync.Mutex
+}
+
+var ErrNilCalendar = errors.New("nil calendar")
+
+type versionedCalendar struct {
+	calendar *Calendar
+	version  uint64
+}
+
+// NewHolder returns a Holder of the calendar, having version 1.
+func NewHolder(calendar *Calendar) (*Holder, error) {
+	if calendar == nil {
+		return nil, ErrNilCalendar
+	}
+
+	holder := &Holder{
+		value: atomic.Value{},
+		mutex: sync.Mutex{},
+	}
+	holder.value.Store(versionedCalendar{calendar: calendar, version: 1})
+
+	return holder, nil
+}
+
+// Load returns the current Calendar and its version.
+func (holder *Holder) Load() (*Calendar, uint64) {
+	current := holder.value.Load().(versionedCalendar) //nolint:forcetypeassert // only versionedCalendar is stored
+
+	return current.calendar, current.version
+}
+
+func (holder *Holder) Calendar() *Calendar {
+	calendar, _ := holder.Load()
+
+	return calendar
+}
+
+func (holder *Holder) Version() uint64 {
+	_, version := holder.Load()
+
+	return version
+}
+
+// Swap replaces the Calendar and returns the new version.
+// The current Calendar is kept, if calendar is nil.
+func (holder *Holder) Swap(calendar *Calendar) (uint64, error) {
+	if calendar == nil {
+		return holder.Version(), ErrNilCalendar
+	}
+
+	holder.mutex.Lock()
+	defer holder.mutex.Unlock()
+
+	version := holder.Version() + 1
+	holder.value.Store(versionedCalendar{calendar: calendar, version: version})
+
+	return version, nil
+}
+
+// Reconfigure replaces the Calendar by a new one, created from config.
+// The current Calendar is kept, if config is invalid.
+func (holder *Holder) Reconfigure(config Config) (uint64, error) {
+	calendar, err := NewCalendar(config)
+	if err != nil {
+		return holder.Version(), err
+	}
+
+	return holder.Swap(calendar)
+}
+
+func (holder *Holder) CalculateDueDate(submitAt time.Time, turnaroundDurationHour float64) (time.Time, error) {
+	return holder.Calendar().CalculateDueDate(submitAt, turnaroundDurationHour)
+}
+
+// CalculateDueDateFunc returns a function, which calculates on the current Calendar at each call.
+// Calendar.CalculateDueDateFunc keeps calculating on the same Calendar.
+func (holder *Holder) CalculateDueDateFunc() func(
+	submitAt time.Time, turnaroundDurationHour float64,
+) (time.Time, error) {
+	return holder.CalculateDueDate
+}
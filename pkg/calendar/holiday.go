@@ -0,0 +1,277 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// Holiday is a non-working day. A partial holiday has shortened WorkBegins - WorkEnds working hours.
+type Holiday struct {
+	Date       Date
+	Name       string
+	WorkBegins time.Duration
+	WorkEnds   time.Duration
+}
+
+func (holiday Holiday) IsPartial() bool {
+	return holiday.WorkBegins != 0 || holiday.WorkEnds != 0
+}
+
+// HolidayRule generates the holidays of a year.
+// A rule may return dates of the neighbouring years, too (for example observed holidays).
+type HolidayRule interface {
+	Holidays(year int) []Holiday
+}
+
+type HolidayFunc func(year int) []Holiday
+
+func (holidayFunc HolidayFunc) Holidays(year int) []Holiday {
+	return holidayFunc(year)
+}
+
+// FixedHoliday is on the same day every year.
+type FixedHoliday struct {
+	Name  string
+	Month time.Month
+	Day   int
+}
+
+func (holiday FixedHoliday) Holidays(year int) []Holiday {
+	return []Holiday{{
+		Date: NewDate(year, holiday.Month, holiday.Day),
+		Name: holiday.Name,
+	}}
+}
+
+// EasterHoliday is Offset days after (or before, if negative) Western Easter Sunday.
+type EasterHoliday struct {
+	Name   string
+	Offset int
+}
+
+func (holiday EasterHoliday) Holidays(year int) []Holiday {
+	return []Holiday{{
+		Date: Easter(year).AddDays(holiday.Offset),
+		Name: holiday.Name,
+	}}
+}
+
+// WeekdayHoliday is on the Nth Weekday of Month. Negative Nth counts from the end of Month, -1 is the last one.
+type WeekdayHoliday struct {
+	Name    string
+	Month   time.Month
+	Weekday time.Weekday
+	Nth     int
+}
+
+func (holiday WeekdayHoliday) Holidays(year int) []Holiday {
+	var date Date
+
+	if holiday.Nth > 0 {
+		first := NewDate(year, holiday.Month, 1)
+		offset := (int(holiday.Weekday) - int(first.Weekday()) + daysPerWeek) % daysPerWeek
+		date = first.AddDays(offset + (holiday.Nth-1)*daysPerWeek)
+	} else {
+		last := NewDate(year, holiday.Month+1, 0)
+		offset := (int(last.Weekday()) - int(holiday.Weekday) + daysPerWeek) % daysPerWeek
+		date = last.AddDays(-offset + (holiday.Nth+1)*daysPerWeek)
+	}
+
+	if date.Month != holiday.Month {
+		return nil
+	}
+
+	return []Holiday{{
+		Date: date,
+		Name: holiday.Name,
+	}}
+}
+
+// ObservedHoliday moves Saturday holidays to the preceding Friday and Sunday holidays to the following Monday.
+type ObservedHoliday struct {
+	Rule HolidayRule
+}
+
+func (holiday ObservedHoliday) Holidays(year int) []Holiday {
+	holidays := holiday.Rule.Holidays(year)
+	observed := make([]Holiday, 0, len(holidays))
+
+	for _, day := range holidays {
+		switch day.Date.Weekday() {
+		case time.Saturday:
+			day.Date = day.Date.AddDays(-1)
+		case time.Sunday:
+			day.Date = day.Date.AddDays(1)
+		default:
+		}
+
+		observed = append(observed, day)
+	}
+
+	return observed
+}
+
+// HolidayYears limits Rule to the years between From and To (inclusive). Zero means unlimited.
+type HolidayYears struct {
+	Rule HolidayRule
+	From int
+	To   int
+}
+
+func (holiday HolidayYears) Holidays(year int) []Holiday {
+	if (holiday.From != 0 && year < holiday.From) || (holiday.To != 0 && year > holiday.To) {
+		return nil
+	}
+
+	return holiday.Rule.Holidays(year)
+}
+
+// PartialHoliday shortens the working hours of Rule holidays, for example Christmas Eve from 9:00 to 13:00.
+// Holidays falling on a non-working day of the week remain non-working days.
+type PartialHoliday struct {
+	Rule       HolidayRule
+	WorkBegins time.Duration
+	WorkEnds   time.Duration
+}
+
+func (holiday PartialHoliday) Holidays(year int) []Holiday {
+	holidays := holiday.Rule.Holidays(year)
+	partials := make([]Holiday, 0, len(holidays))
+
+	for _, day := range holidays {
+		day.WorkBegins = holiday.WorkBegins
+		day.WorkEnds = holiday.WorkEnds
+		partials = append(partials, day)
+	}
+
+	return partials
+}
+
+func validateHolidays(holidays []HolidayRule) error {
+	for h, holiday := range holidays {
+		if holiday == nil {
+			return fmt.Errorf(
+				"%w: #%d is nil", ErrInvalidHoliday, h,
+			)
+		}
+
+		if partial, invalid := invalidPartialHoliday(holiday); invalid {
+			return fmt.Errorf(
+				"%w: #%d, %s - %s",
+				ErrInvalidHoliday, h, partial.WorkBegins.String(), partial.WorkEnds.String(),
+			)
+		}
+	}
+
+	return nil
+}
+
+// invalidPartialHoliday returns the partial holiday having invalid working hours in the rule,
+// including the rules wrapped by HolidayYears, ObservedHoliday and PartialHoliday.
+func invalidPartialHoliday(rule HolidayRule) (PartialHoliday, bool) {
+	switch holiday := rule.(type) {
+	case PartialHoliday:
+		if holiday.WorkBegins < 0 || holiday.WorkEnds > hoursPerDay*time.Hour ||
+			holiday.WorkBegins >= holiday.WorkEnds {
+			return holiday, true
+		}
+
+		return invalidPartialHoliday(holiday.Rule)
+	case HolidayYears:
+		return invalidPartialHoliday(holiday.Rule)
+	case ObservedHoliday:
+		return invalidPartialHoliday(holiday.Rule)
+	default:
+		return PartialHoliday{}, false
+	}
+}
+
+// partialHolidayEnds returns the latest end of the partial holidays in the rule, including the wrapped ones.
+func partialHolidayEnds(rule HolidayRule) time.Duration {
+	switch holiday := rule.(type) {
+	case PartialHoliday:
+		if ends := partialHolidayEnds(holiday.Rule); ends > holiday.WorkEnds {
+			return ends
+		}
+
+		return holiday.WorkEnds
+	case HolidayYears:
+		return partialHolidayEnds(holiday.Rule)
+	case ObservedHoliday:
+		return partialHolidayEnds(holiday.Rule)
+	default:
+		return 0
+	}
+}
+
+// Easter returns the Western (Gregorian) Easter Sunday of the year, see Meeus/Jones/Butcher algorithm.
+//
+//nolint:gomnd // magic numbers of the algorithm
+func Easter(year int) Date {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return NewDate(year, time.Month(month), day)
+}
+
+func (config *Config) holiday(date Date) (Holiday, bool) {
+	for _, rule := range config.Holidays {
+		for year := date.Year - 1; year <= date.Year+1; year++ {
+			for _, holiday := range rule.Holidays(year) {
+				if holiday.Date == date {
+					return holiday, true
+				}
+			}
+		}
+	}
+
+	return Holiday{}, false
+}
+
+// yearHolidays returns the holidays of the year. If more rules match a date, the first one is used.
+func (config *Config) yearHolidays(year int) map[Date]Holiday {
+	holidays := map[Date]Holiday{}
+
+	for _, rule := range config.Holidays {
+		for ruleYear := year - 1; ruleYear <= year+1; ruleYear++ {
+			for _, holiday := range rule.Holidays(ruleYear) {
+				if _, has := holidays[holiday.Date]; !has && holiday.Date.Year == year {
+					holidays[holiday.Date] = holiday
+				}
+			}
+		}
+	}
+
+	return holidays
+}
+
+// Holidays returns the holidays between from and to (inclusive), ordered by date.
+func (calendar *Calendar) Holidays(from, to Date) []Holiday {
+	holidays := []Holiday{}
+
+	for date := from; !to.Before(date); date = date.AddDays(1) {
+		if holiday, is := calendar.index.holiday(date); is {
+			holidays = append(holidays, holiday)
+		}
+	}
+
+	return holidays
+}
+
+func (calendar *Calendar) IsHoliday(date Date) bool {
+	_, is := calendar.index.holiday(date)
+
+	return is
+}
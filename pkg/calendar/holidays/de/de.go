@@ -0,0 +1,119 @@
+// Package de contains the public holidays of Germany and its states.
+package de
+
+import (
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+// State is the ISO 3166-2:DE code of a German state, without the country prefix.
+type State string
+
+const (
+	Nationwide            State = ""
+	BadenWuerttemberg     State = "BW"
+	Bayern                State = "BY"
+	Berlin                State = "BE"
+	Brandenburg           State = "BB"
+	Bremen                State = "HB"
+	Hamburg               State = "HH"
+	Hessen                State = "HE"
+	MecklenburgVorpommern State = "MV"
+	Niedersachsen         State = "NI"
+	NordrheinWestfalen    State = "NW"
+	RheinlandPfalz        State = "RP"
+	Saarland              State = "SL"
+	Sachsen               State = "SN"
+	SachsenAnhalt         State = "ST"
+	SchleswigHolstein     State = "SH"
+	Thueringen            State = "TH"
+)
+
+const (
+	germanUnityDayFrom       = 1990
+	reformationJubilee       = 2017
+	reformationNorthFrom     = 2018
+	internationalWomensDayBE = 2019
+	internationalWomensDayMV = 2023
+	worldChildrensDayFrom    = 2019
+	daysPerWeek              = 7
+)
+
+// Holidays returns the nationwide holidays and the holidays of the given state.
+//
+//nolint:gomnd // holiday dates
+func Holidays(state State) []calendar.HolidayRule {
+	holidays := []calendar.HolidayRule{
+		calendar.FixedHoliday{Name: "Neujahr", Month: time.January, Day: 1},
+		calendar.EasterHoliday{Name: "Karfreitag", Offset: -2},
+		calendar.EasterHoliday{Name: "Ostermontag", Offset: 1},
+		calendar.FixedHoliday{Name: "Tag der Arbeit", Month: time.May, Day: 1},
+		calendar.EasterHoliday{Name: "Christi Himmelfahrt", Offset: 39},
+		calendar.EasterHoliday{Name: "Pfingstmontag", Offset: 50},
+		calendar.HolidayYears{
+			Rule: calendar.FixedHoliday{Name: "Tag der Deutschen Einheit", Month: time.October, Day: 3},
+			From: germanUnityDayFrom,
+		},
+		calendar.HolidayYears{
+			Rule: reformationDay,
+			From: reformationJubilee,
+			To:   reformationJubilee,
+		},
+		calendar.FixedHoliday{Name: "1. Weihnachtstag", Month: time.December, Day: 25},
+		calendar.FixedHoliday{Name: "2. Weihnachtstag", Month: time.December, Day: 26},
+	}
+
+	return append(holidays, stateHolidays[state]...)
+}
+
+//nolint:gochecknoglobals,gomnd // immutable rule values
+var (
+	epiphany       = calendar.FixedHoliday{Name: "Heilige Drei Könige", Month: time.January, Day: 6}
+	corpusChristi  = calendar.EasterHoliday{Name: "Fronleichnam", Offset: 60}
+	assumptionDay  = calendar.FixedHoliday{Name: "Mariä Himmelfahrt", Month: time.August, Day: 15}
+	allSaintsDay   = calendar.FixedHoliday{Name: "Allerheiligen", Month: time.November, Day: 1}
+	reformationDay = calendar.FixedHoliday{Name: "Reformationstag", Month: time.October, Day: 31}
+	womensDay      = calendar.FixedHoliday{Name: "Internationaler Frauentag", Month: time.March, Day: 8}
+	childrensDay   = calendar.FixedHoliday{Name: "Weltkindertag", Month: time.September, Day: 20}
+	repentanceDay  = calendar.HolidayFunc(func(year int) []calendar.Holiday {
+		// Wednesday before November 23
+		latest := calendar.NewDate(year, time.November, 22)
+		offset := (int(latest.Weekday()) - int(time.Wednesday) + daysPerWeek) % daysPerWeek
+
+		return []calendar.Holiday{{Date: latest.AddDays(-offset), Name: "Buß- und Bettag"}}
+	})
+
+	reformationDayNorth = calendar.HolidayYears{Rule: reformationDay, From: reformationNorthFrom}
+
+	stateHolidays = map[State][]calendar.HolidayRule{
+		BadenWuerttemberg: {epiphany, corpusChristi, allSaintsDay},
+		Bayern:            {epiphany, corpusChristi, allSaintsDay},
+		Berlin: {
+			calendar.HolidayYears{Rule: womensDay, From: internationalWomensDayBE},
+		},
+		Brandenburg: {
+			calendar.EasterHoliday{Name: "Ostersonntag", Offset: 0},
+			calendar.EasterHoliday{Name: "Pfingstsonntag", Offset: 49},
+			reformationDay,
+		},
+		Bremen:  {reformationDayNorth},
+		Hamburg: {reformationDayNorth},
+		Hessen:  {corpusChristi},
+		MecklenburgVorpommern: {
+			calendar.HolidayYears{Rule: womensDay, From: internationalWomensDayMV},
+			reformationDay,
+		},
+		Niedersachsen:      {reformationDayNorth},
+		NordrheinWestfalen: {corpusChristi, allSaintsDay},
+		RheinlandPfalz:     {corpusChristi, allSaintsDay},
+		Saarland:           {corpusChristi, assumptionDay, allSaintsDay},
+		Sachsen:            {reformationDay, repentanceDay},
+		SachsenAnhalt:      {epiphany, reformationDay},
+		SchleswigHolstein:  {reformationDayNorth},
+		Thueringen: {
+			calendar.HolidayYears{Rule: childrensDay, From: worldChildrensDayFrom},
+			reformationDay,
+		},
+	}
+)
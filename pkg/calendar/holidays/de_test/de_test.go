@@ -0,0 +1,111 @@
+package de_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/de"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeTestSuite struct {
+	suite.Suite
+}
+
+func TestDeTestSuite(t *testing.T) {
+	suite.Run(t, new(DeTestSuite))
+}
+
+func (s *DeTestSuite) TestHolidays() {
+	testCases := []struct {
+		name string
+
+		state de.State
+		year  int
+
+		expectedDates []string
+	}{
+		{
+			name:  "Nationwide 2021",
+			state: de.Nationwide,
+			year:  2021,
+			expectedDates: []string{
+				"2021-01-01", "2021-04-02", "2021-04-05", "2021-05-01", "2021-05-13", "2021-05-24", "2021-10-03",
+				"2021-12-25", "2021-12-26",
+			},
+		},
+		{
+			name:  "Nationwide 2017 with Reformation Day",
+			state: de.Nationwide,
+			year:  2017,
+			expectedDates: []string{
+				"2017-01-01", "2017-04-14", "2017-04-17", "2017-05-01", "2017-05-25", "2017-06-05", "2017-10-03",
+				"2017-10-31", "2017-12-25", "2017-12-26",
+			},
+		},
+		{
+			name:  "Bayern 2021",
+			state: de.Bayern,
+			year:  2021,
+			expectedDates: []string{
+				"2021-01-01", "2021-01-06", "2021-04-02", "2021-04-05", "2021-05-01", "2021-05-13", "2021-05-24",
+				"2021-06-03", "2021-10-03", "2021-11-01", "2021-12-25", "2021-12-26",
+			},
+		},
+		{
+			name:  "Sachsen 2021",
+			state: de.Sachsen,
+			year:  2021,
+			expectedDates: []string{
+				"2021-01-01", "2021-04-02", "2021-04-05", "2021-05-01", "2021-05-13", "2021-05-24", "2021-10-03",
+				"2021-10-31", "2021-11-17", "2021-12-25", "2021-12-26",
+			},
+		},
+		{
+			name:  "Berlin 2018 without Women's Day",
+			state: de.Berlin,
+			year:  2018,
+			expectedDates: []string{
+				"2018-01-01", "2018-03-30", "2018-04-02", "2018-05-01", "2018-05-10", "2018-05-21", "2018-10-03",
+				"2018-12-25", "2018-12-26",
+			},
+		},
+		{
+			name:  "Berlin 2019",
+			state: de.Berlin,
+			year:  2019,
+			expectedDates: []string{
+				"2019-01-01", "2019-03-08", "2019-04-19", "2019-04-22", "2019-05-01", "2019-05-30", "2019-06-10",
+				"2019-10-03", "2019-12-25", "2019-12-26",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			calendarTest, err := calendar.NewCalendar(calendar.Config{
+				FirstWorkday:   calendar.FirstWorkdayDefault,
+				WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+				WorkBegins:     calendar.WorkBeginsDefault,
+				WorkEnds:       calendar.WorkEndsDefault,
+				TimeFormat:     calendar.TimeFormatDefault,
+				Holidays:       de.Holidays(testCase.state),
+			})
+			s.Require().NoError(err)
+
+			holidays := calendarTest.Holidays(
+				calendar.NewDate(testCase.year, time.January, 1),
+				calendar.NewDate(testCase.year, time.December, 31),
+			)
+
+			dates := make([]string, 0, len(holidays))
+			for _, holiday := range holidays {
+				dates = append(dates, holiday.Date.String())
+			}
+
+			s.Assert().Equal(testCase.expectedDates, dates)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Package hu contains the public holidays of Hungary.
+package hu
+
+import (
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+const goodFridayFrom = 2017
+
+//nolint:gomnd // holiday dates
+func Holidays() []calendar.HolidayRule {
+	return []calendar.HolidayRule{
+		calendar.FixedHoliday{Name: "Újév", Month: time.January, Day: 1},
+		calendar.FixedHoliday{Name: "Nemzeti ünnep", Month: time.March, Day: 15},
+		calendar.HolidayYears{
+			Rule: calendar.EasterHoliday{Name: "Nagypéntek", Offset: -2},
+			From: goodFridayFrom,
+		},
+		calendar.EasterHoliday{Name: "Húsvétvasárnap", Offset: 0},
+		calendar.EasterHoliday{Name: "Húsvéthétfő", Offset: 1},
+		calendar.FixedHoliday{Name: "A munka ünnepe", Month: time.May, Day: 1},
+		calendar.EasterHoliday{Name: "Pünkösdvasárnap", Offset: 49},
+		calendar.EasterHoliday{Name: "Pünkösdhétfő", Offset: 50},
+		calendar.FixedHoliday{Name: "Az államalapítás ünnepe", Month: time.August, Day: 20},
+		calendar.FixedHoliday{Name: "Nemzeti ünnep", Month: time.October, Day: 23},
+		calendar.FixedHoliday{Name: "Mindenszentek", Month: time.November, Day: 1},
+		calendar.FixedHoliday{Name: "Karácsony", Month: time.December, Day: 25},
+		calendar.FixedHoliday{Name: "Karácsony másnapja", Month: time.December, Day: 26},
+	}
+}
@@ -0,0 +1,80 @@
+package hu_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/hu"
+	"github.com/stretchr/testify/suite"
+)
+
+type HuTestSuite struct {
+	suite.Suite
+}
+
+func TestHuTestSuite(t *testing.T) {
+	suite.Run(t, new(HuTestSuite))
+}
+
+func (s *HuTestSuite) TestHolidays() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		year int
+
+		expectedDates []string
+	}{
+		{
+			name: "2016 without Good Friday",
+			year: 2016,
+			expectedDates: []string{
+				"2016-01-01", "2016-03-15", "2016-03-27", "2016-03-28", "2016-05-01", "2016-05-15", "2016-05-16",
+				"2016-08-20", "2016-10-23", "2016-11-01", "2016-12-25", "2016-12-26",
+			},
+		},
+		{
+			name: "2021",
+			year: 2021,
+			expectedDates: []string{
+				"2021-01-01", "2021-03-15", "2021-04-02", "2021-04-04", "2021-04-05", "2021-05-01", "2021-05-23",
+				"2021-05-24", "2021-08-20", "2021-10-23", "2021-11-01", "2021-12-25", "2021-12-26",
+			},
+		},
+		{
+			name: "2024",
+			year: 2024,
+			expectedDates: []string{
+				"2024-01-01", "2024-03-15", "2024-03-29", "2024-03-31", "2024-04-01", "2024-05-01", "2024-05-19",
+				"2024-05-20", "2024-08-20", "2024-10-23", "2024-11-01", "2024-12-25", "2024-12-26",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			holidays := calendarTest.Holidays(
+				calendar.NewDate(testCase.year, time.January, 1),
+				calendar.NewDate(testCase.year, time.December, 31),
+			)
+
+			dates := make([]string, 0, len(holidays))
+			for _, holiday := range holidays {
+				dates = append(dates, holiday.Date.String())
+			}
+
+			s.Assert().Equal(testCase.expectedDates, dates)
+		})
+	}
+}
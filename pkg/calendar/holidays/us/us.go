@@ -0,0 +1,50 @@
+// Package us contains the federal holidays of the United States.
+package us
+
+import (
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+const (
+	mlkDayFrom     = 1986
+	juneteenthFrom = 2021
+)
+
+//nolint:gomnd // holiday dates
+func Holidays() []calendar.HolidayRule {
+	return []calendar.HolidayRule{
+		calendar.ObservedHoliday{
+			Rule: calendar.FixedHoliday{Name: "New Year's Day", Month: time.January, Day: 1},
+		},
+		calendar.HolidayYears{
+			Rule: calendar.WeekdayHoliday{
+				Name: "Birthday of Martin Luther King, Jr.", Month: time.January, Weekday: time.Monday, Nth: 3,
+			},
+			From: mlkDayFrom,
+		},
+		calendar.WeekdayHoliday{Name: "Washington's Birthday", Month: time.February, Weekday: time.Monday, Nth: 3},
+		calendar.WeekdayHoliday{Name: "Memorial Day", Month: time.May, Weekday: time.Monday, Nth: -1},
+		calendar.HolidayYears{
+			Rule: calendar.ObservedHoliday{
+				Rule: calendar.FixedHoliday{
+					Name: "Juneteenth National Independence Day", Month: time.June, Day: 19,
+				},
+			},
+			From: juneteenthFrom,
+		},
+		calendar.ObservedHoliday{
+			Rule: calendar.FixedHoliday{Name: "Independence Day", Month: time.July, Day: 4},
+		},
+		calendar.WeekdayHoliday{Name: "Labor Day", Month: time.September, Weekday: time.Monday, Nth: 1},
+		calendar.WeekdayHoliday{Name: "Columbus Day", Month: time.October, Weekday: time.Monday, Nth: 2},
+		calendar.ObservedHoliday{
+			Rule: calendar.FixedHoliday{Name: "Veterans Day", Month: time.November, Day: 11},
+		},
+		calendar.WeekdayHoliday{Name: "Thanksgiving Day", Month: time.November, Weekday: time.Thursday, Nth: 4},
+		calendar.ObservedHoliday{
+			Rule: calendar.FixedHoliday{Name: "Christmas Day", Month: time.December, Day: 25},
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package us_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/us"
+	"github.com/stretchr/testify/suite"
+)
+
+type UsTestSuite struct {
+	suite.Suite
+}
+
+func TestUsTestSuite(t *testing.T) {
+	suite.Run(t, new(UsTestSuite))
+}
+
+func (s *UsTestSuite) TestHolidays() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       us.Holidays(),
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		year int
+
+		expectedDates []string
+	}{
+		{
+			name: "1985 without MLK Day",
+			year: 1985,
+			expectedDates: []string{
+				"1985-01-01", "1985-02-18", "1985-05-27", "1985-07-04", "1985-09-02", "1985-10-14", "1985-11-11",
+				"1985-11-28", "1985-12-25",
+			},
+		},
+		{
+			name: "2021 with observed days and next New Year's Day",
+			year: 2021,
+			expectedDates: []string{
+				"2021-01-01", "2021-01-18", "2021-02-15", "2021-05-31", "2021-06-18", "2021-07-05", "2021-09-06",
+				"2021-10-11", "2021-11-11", "2021-11-25", "2021-12-24", "2021-12-31",
+			},
+		},
+		{
+			name: "2023",
+			year: 2023,
+			expectedDates: []string{
+				"2023-01-02", "2023-01-16", "2023-02-20", "2023-05-29", "2023-06-19", "2023-07-04", "2023-09-04",
+				"2023-10-09", "2023-11-10", "2023-11-23", "2023-12-25",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			holidays := calendarTest.Holidays(
+				calendar.NewDate(testCase.year, time.January, 1),
+				calendar.NewDate(testCase.year, time.December, 31),
+			)
+
+			dates := make([]string, 0, len(holidays))
+			for _, holiday := range holidays {
+				dates = append(dates, holiday.Date.String())
+			}
+
+			s.Assert().Equal(testCase.expectedDates, dates)
+		})
+	}
+}
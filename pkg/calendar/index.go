@@ -0,0 +1,543 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// workIndex caches the working hours and the working-time prefix sums of the days, year by year,
+// and the prefix sums of the years. The years are calculated lazily. It's safe for concurrent use.
+// Only the years between cacheFrom and cacheTo (inclusive) are cached, from the others only the last used ones.
+type workIndex struct {
+	config    Config
+	years     sync.Map
+	cacheFrom int
+	cacheTo   int
+	// farYears are the *yearProfile of the last used years out of the cached ones, by the year modulo farYearSlots
+	farYears [farYearSlots]atomic.Value
+
+	// totals is the *yearTotals of the years calculated so far, replaced by a longer one under totalsLock
+	totals     atomic.Value
+	totalsLock sync.Mutex
+
+	rangeOnce sync.Once
+	rangeDays *dayRange
+}
+
+// yearTotals is the working-time prefix sums of consecutive years, so the working time of many years is O(1).
+type yearTotals struct {
+	first int
+	// cumulative is the working duration of the years before the year, the last item is the total of the years
+	cumulative []time.Duration
+}
+
+// dayRange is the working-time prefix sums of the days between Config.IndexFromYear and Config.IndexToYear.
+type dayRange struct {
+	first Date
+	// cumulative is the working duration of the range before the day, the last item is the total of the range
+	cumulative []time.Duration
+}
+
+type yearProfile struct {
+	year     int
+	holidays map[Date]Holiday
+	begins   []time.Duration
+	ends     []time.Duration
+	// cumulative is the working duration of the year before the day, the last item is the total of the year
+	cumulative []time.Duration
+}
+
+const (
+	// cacheMarginYears is the years cached around the index years or, without index, around the current year.
+	cacheMarginYears = 100
+	// maxYearsSearched limits the years searched for a working duration.
+	maxYearsSearched = 1 << 14
+	// maxYearsSummed years have less working time than the longest time.Duration, even if every hour is working.
+	maxYearsSummed = 200
+	// farYearSlots is the number of the last used years kept out of the cached ones.
+	farYearSlots = 16
+)
+
+func newWorkIndex(config Config) *workIndex {
+	cacheFrom, cacheTo := config.IndexFromYear, config.IndexToYear
+	if cacheFrom == 0 {
+		cacheFrom = time.Now().Year()
+		cacheTo = cacheFrom
+	}
+
+	return &workIndex{
+		config:     config,
+		years:      sync.Map{},
+		cacheFrom:  cacheFrom - cacheMarginYears,
+		cacheTo:    cacheTo + cacheMarginYears,
+		farYears:   [farYearSlots]atomic.Value{},
+		totals:     atomic.Value{},
+		totalsLock: sync.Mutex{},
+		rangeOnce:  sync.Once{},
+		rangeDays:  nil,
+	}
+}
+
+func yearDay(date Date) int {
+	return date.In(time.UTC).YearDay() - 1
+}
+
+// dayRange returns the index of the year range, building it at the first call. It's nil, if not enabled.
+func (index *workIndex) dayRange() *dayRange {
+	if index.config.IndexFromYear == 0 {
+		return nil
+	}
+
+	index.rangeOnce.Do(func() {
+		rangeDays := &dayRange{
+			first:      NewDate(index.config.IndexFromYear, time.January, 1),
+			cumulative: []time.Duration{0},
+		}
+
+		for year := index.config.IndexFromYear; year <= index.config.IndexToYear; year++ {
+			profile := index.profile(year)
+			offset := rangeDays.cumulative[len(rangeDays.cumulative)-1]
+
+			for _, cumulative := range profile.cumulative[1:] {
+				rangeDays.cumulative = append(rangeDays.cumulative, offset+cumulative)
+			}
+		}
+
+		index.rangeDays = rangeDays
+	})
+
+	return index.rangeDays
+}
+
+// day returns the number of days from the first day of the range, and whether the day is in the range.
+// The day after the last one is accepted, too.
+func (rangeDays *dayRange) day(date Date) (int, bool) {
+	day := int(date.In(time.UTC).Sub(rangeDays.first.In(time.UTC)) / (hoursPerDay * time.Hour))
+
+	return day, day >= 0 && day < len(rangeDays.cumulative)
+}
+
+func (index *workIndex) profile(year int) *yearProfile {
+	if profile, has := index.years.Load(year); has {
+		return profile.(*yearProfile) //nolint:forcetypeassert // only *yearProfile is stored
+	}
+
+	farYear := &index.farYears[(year%farYearSlots+farYearSlots)%farYearSlots]
+	if profile, _ := farYear.Load().(*yearProfile); profile != nil && profile.year == year {
+		return profile
+	}
+
+	first := NewDate(year, time.January, 1)
+	days := yearDay(NewDate(year, time.December, 31)) + 1
+	profile := &yearProfile{
+		year:       year,
+		holidays:   index.config.yearHolidays(year),
+		begins:     make([]time.Duration, days),
+		ends:       make([]time.Duration, days),
+		cumulative: make([]time.Duration, days+1),
+	}
+
+	for day := 0; day < days; day++ {
+		date := first.AddDays(day)
+		holiday, isHoliday := profile.holidays[date]
+
+		begins, ends, working := index.config.dayWorkHours(date, holiday, isHoliday)
+		if working {
+			profile.begins[day] = begins
+			profile.ends[day] = ends
+		}
+
+		profile.cumulative[day+1] = profile.cumulative[day] + profile.ends[day] - profile.begins[day]
+	}
+
+	if year < index.cacheFrom || year > index.cacheTo {
+		farYear.Store(profile)
+
+		return profile
+	}
+
+	stored, _ := index.years.LoadOrStore(year, profile)
+
+	return stored.(*yearProfile) //nolint:forcetypeassert // only *yearProfile is stored
+}
+
+func (profile *yearProfile) total() time.Duration {
+	return profile.cumulative[len(profile.cumulative)-1]
+}
+
+// yearsDuration returns the working duration of the years from (inclusive) to (exclusive).
+func (index *workIndex) yearsDuration(from, to int) time.Duration {
+	if from >= to {
+		return 0
+	}
+
+	totals := index.yearTotals(from, to)
+
+	return totals.cumulative[to-totals.first] - totals.cumulative[from-totals.first]
+}
+
+// yearTotals returns the prefix sums of the years, covering the years from (inclusive) to (exclusive).
+// A longer one is built by need, so the readers are not blocked by the others.
+func (index *workIndex) yearTotals(from, to int) *yearTotals {
+	if totals, _ := index.totals.Load().(*yearTotals); totals != nil && totals.covers(from, to) {
+		return totals
+	}
+
+	index.totalsLock.Lock()
+	defer index.totalsLock.Unlock()
+
+	totals, _ := index.totals.Load().(*yearTotals)
+	if totals == nil {
+		totals = &yearTotals{first: from, cumulative: []time.Duration{0}}
+	} else if totals.covers(from, to) {
+		return totals
+	}
+
+	first, last := totals.first, totals.first+len(totals.cumulative)-1
+	if from < first {
+		first = from
+	}
+
+	if to > last {
+		last = to
+	}
+
+	// only the new years are calculated, the known ones are shifted by the working time of the years before them
+	extended := &yearTotals{first: first, cumulative: make([]time.Duration, 1, last-first+1)}
+	for year := first; year < totals.first; year++ {
+		extended.cumulative = append(extended.cumulative, extended.cumulative[year-first]+index.profile(year).total())
+	}
+
+	offset := extended.cumulative[len(extended.cumulative)-1]
+	for _, cumulative := range totals.cumulative[1:] {
+		extended.cumulative = append(extended.cumulative, offset+cumulative)
+	}
+
+	for year := totals.first + len(totals.cumulative) - 1; year < last; year++ {
+		extended.cumulative = append(extended.cumulative, extended.cumulative[year-first]+index.profile(year).total())
+	}
+
+	index.totals.Store(extended)
+
+	return extended
+}
+
+func (totals *yearTotals) covers(from, to int) bool {
+	return from >= totals.first && to < totals.first+len(totals.cumulative)
+}
+
+func (index *workIndex) holiday(date Date) (Holiday, bool) {
+	holiday, is := index.profile(date.Year).holidays[date]
+
+	return holiday, is
+}
+
+func (index *workIndex) workHours(date Date) (time.Duration, time.Duration, bool) {
+	profile := index.profile(date.Year)
+	day := yearDay(date)
+
+	return profile.begins[day], profile.ends[day], profile.ends[day] > profile.begins[day]
+}
+
+// isWorkTime reports whether at is in the working hours of its day, including the end of the working hours.
+func (index *workIndex) isWorkTime(at time.Time) bool {
+	begins, ends, working := index.workHours(DateOf(at))
+
+	return working && !at.Before(calculateDayTime(at, begins)) && !at.After(calculateDayTime(at, ends))
+}
+
+// daysDuration returns the working duration of the days from (inclusive) to (exclusive).
+func (index *workIndex) daysDuration(from, to Date) time.Duration {
+	if !from.Before(to) {
+		return 0
+	}
+
+	if rangeDays := index.dayRange(); rangeDays != nil {
+		fromDay, fromIn := rangeDays.day(from)
+		toDay, toIn := rangeDays.day(to)
+
+		if fromIn && toIn {
+			return rangeDays.cumulative[toDay] - rangeDays.cumulative[fromDay]
+		}
+	}
+
+	fromProfile := index.profile(from.Year)
+
+	if from.Year == to.Year {
+		return fromProfile.cumulative[yearDay(to)] - fromProfile.cumulative[yearDay(from)]
+	}
+
+	return fromProfile.total() - fromProfile.cumulative[yearDay(from)] +
+		index.yearsDuration(from.Year+1, to.Year) + index.profile(to.Year).cumulative[yearDay(to)]
+}
+
+// dayDuration returns the working duration of the date between from and to.
+func (index *workIndex) dayDuration(date Date, from, to time.Time) time.Duration {
+	begins, ends, working := index.workHours(date)
+	if !working {
+		return 0
+	}
+
+	beginsAt := date.In(from.Location()).Add(begins)
+	endsAt := date.In(from.Location()).Add(ends)
+
+	if beginsAt.Before(from) {
+		beginsAt = from
+	}
+
+	if endsAt.After(to) {
+		endsAt = to
+	}
+
+	if !endsAt.After(beginsAt) {
+		return 0
+	}
+
+	return endsAt.Sub(beginsAt)
+}
+
+// workDuration returns the working time between from and to.
+func (index *workIndex) workDuration(from, to time.Time) time.Duration {
+	to = to.In(from.Location())
+	fromDate := DateOf(from)
+	toDate := DateOf(to)
+
+	if !fromDate.Before(toDate) {
+		return index.dayDuration(fromDate, from, to)
+	}
+
+	return index.dayDuration(fromDate, from, fromDate.AddDays(1).In(from.Location())) +
+		index.daysDuration(fromDate.AddDays(1), toDate) +
+		index.dayDuration(toDate, toDate.In(from.Location()), to)
+}
+
+// workDurationSaturated returns the working time between from and to like workDuration,
+// but MaxWorkingDuration, if it's longer. It's summed by maxYearsSummed years, which can't overflow.
+func (index *workIndex) workDurationSaturated(from, to time.Time) time.Duration {
+	duration := time.Duration(0)
+
+	for {
+		partTo := NewDate(DateOf(from).Year+maxYearsSummed, time.January, 1).In(from.Location())
+		if !partTo.Before(to) {
+			partTo = to
+		}
+
+		part := index.workDuration(from, partTo)
+		if part > MaxWorkingDuration-duration {
+			return MaxWorkingDuration
+		}
+
+		duration += part
+
+		if !partTo.Before(to) {
+			return duration
+		}
+
+		from = partTo
+	}
+}
+
+// weeksWithin returns the most weeks from at, having not more working time than duration.
+// The weeks are searched by binary search till the date, where the working time reaches duration.
+func (index *workIndex) weeksWithin(at time.Time, duration time.Duration) (int, error) {
+	dueDate, err := index.dateAfter(at, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	low, high := 0, DateOf(at).daysUntil(dueDate)/daysPerWeek+1
+
+	for high-low > 1 {
+		middle := (low + high) / 2 //nolint:gomnd // half
+
+		// the working time may wrap over time.Duration next to the longest turnaround, but it fits to uint64
+		if uint64(index.workDuration(at, addDays(at, middle*daysPerWeek))) <= uint64(duration) {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+
+	return low, nil
+}
+
+// dateAfter returns the date, where the working time from at reaches duration.
+func (index *workIndex) dateAfter(at time.Time, duration time.Duration) (Date, error) {
+	date := DateOf(at)
+	year := date.Year
+	profile := index.profile(year)
+	// target is the working time from the beginning of the year
+	target := profile.cumulative[yearDay(date)] + index.dayDuration(date, date.In(at.Location()), at) + duration
+
+	if target > profile.total() {
+		target -= profile.total()
+		var err error
+
+		if year, err = index.yearAfter(date.Year, target); err != nil {
+			return Date{}, err
+		}
+
+		target -= index.yearsDuration(date.Year+1, year)
+		profile = index.profile(year)
+	}
+
+	day := sort.Search(len(profile.cumulative)-1, func(day int) bool {
+		return profile.cumulative[day+1] >= target
+	})
+
+	return NewDate(year, time.January, 1).AddDays(day), nil
+}
+
+// yearAfter returns the first year after the year, where the working time from the year reaches duration.
+// Only holidays can make the working time so rare, that it's not reached in maxYearsSearched years.
+func (index *workIndex) yearAfter(year int, duration time.Duration) (int, error) {
+	low, high := 0, 1
+
+	for index.yearsDuration(year+1, year+1+high) < duration {
+		if high >= maxYearsSearched {
+			return 0, fmt.Errorf("%w: less working time than %s in %d years after %d",
+				ErrInvalidHoliday, duration, maxYearsSearched, year,
+			)
+		}
+
+		low = high
+		high *= 2
+	}
+
+	for high-low > 1 {
+		middle := (low + high) / 2 //nolint:gomnd // half
+
+		if index.yearsDuration(year+1, year+1+middle) < duration {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+
+	return year + high, nil
+}
+
+// workTimeBefore returns the time, having duration (positive) working time till at.
+func (index *workIndex) workTimeBefore(at time.Time, duration time.Duration) time.Time {
+	date := DateOf(at)
+	midnight := date.In(at.Location())
+
+	remaining := duration - index.dayDuration(date, midnight, at)
+	if remaining < 0 {
+		_, ends, _ := index.workHours(date)
+
+		if endsAt := midnight.Add(ends); at.After(endsAt) {
+			at = endsAt
+		}
+
+		return at.Add(-duration)
+	}
+
+	if rangeDays := index.dayRange(); rangeDays != nil {
+		if day, in := rangeDays.day(date); in && rangeDays.cumulative[day] > remaining {
+			return index.searchDays(rangeDays.first, rangeDays.cumulative[:day+1], remaining, at.Location())
+		}
+	}
+
+	year := date.Year
+	cumulative := index.profile(year).cumulative[:yearDay(date)+1]
+
+	if cumulative[len(cumulative)-1] <= remaining {
+		remaining -= cumulative[len(cumulative)-1]
+		year = index.yearBefore(year, remaining)
+		remaining -= index.yearsDuration(year+1, date.Year)
+		cumulative = index.profile(year).cumulative
+	}
+
+	return index.searchDays(NewDate(year, time.January, 1), cumulative, remaining, at.Location())
+}
+
+// yearBefore returns the last year before the year, where the working time till the year is more than duration.
+func (index *workIndex) yearBefore(year int, duration time.Duration) int {
+	low, high := 0, 1
+
+	for index.yearsDuration(year-high, year) <= duration {
+		if high >= maxYearsSearched {
+			return year - high
+		}
+
+		low = high
+		high *= 2
+	}
+
+	for high-low > 1 {
+		middle := (low + high) / 2 //nolint:gomnd // half
+
+		if index.yearsDuration(year-middle, year) <= duration {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+
+	return year - high
+}
+
+// searchDays returns the time, having remaining working time till the end of the prefix sums of the days from first.
+// The last item of cumulative must be greater than remaining.
+func (index *workIndex) searchDays(
+	first Date, cumulative []time.Duration, remaining time.Duration, location *time.Location,
+) time.Time {
+	target := cumulative[len(cumulative)-1] - remaining
+	day := sort.Search(len(cumulative)-1, func(day int) bool {
+		return cumulative[day+1] >= target
+	})
+	date := first.AddDays(day)
+	begins, _, _ := index.workHours(date)
+
+	return date.In(location).Add(begins + target - cumulative[day])
+}
+
+// nextWorkTime returns at, if it's in the working hours (including the end), otherwise the next beginning of them.
+func (index *workIndex) nextWorkTime(at time.Time) (time.Time, error) {
+	if index.isWorkTime(at) {
+		return at, nil
+	}
+
+	date := DateOf(at)
+
+	if begins, _, working := index.workHours(date); working && at.Before(calculateDayTime(at, begins)) {
+		return calculateDayTime(at, begins), nil
+	}
+
+	date, err := index.nextWorkDate(date)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	begins, _, _ := index.workHours(date)
+
+	return date.In(at.Location()).Add(begins), nil
+}
+
+// nextWorkDate returns the first working day after the date.
+func (index *workIndex) nextWorkDate(date Date) (Date, error) {
+	profile := index.profile(date.Year)
+
+	for day := yearDay(date) + 1; day < len(profile.begins); day++ {
+		if profile.ends[day] > profile.begins[day] {
+			return NewDate(date.Year, time.January, 1).AddDays(day), nil
+		}
+	}
+
+	// the first year having any working time
+	year, err := index.yearAfter(date.Year, 1)
+	if err != nil {
+		return Date{}, err
+	}
+
+	profile = index.profile(year)
+	day := sort.Search(len(profile.cumulative)-1, func(day int) bool {
+		return profile.cumulative[day+1] > 0
+	})
+
+	return NewDate(year, time.January, 1).AddDays(day), nil
+}
@@ -0,0 +1,258 @@
+package calendar
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WorkIndexTestSuite struct {
+	suite.Suite
+}
+
+func TestWorkIndexTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkIndexTestSuite))
+}
+
+func naiveWorkDuration(config *Config, from, to time.Time) time.Duration {
+	duration := time.Duration(0)
+	to = to.In(from.Location())
+
+	for date := DateOf(from); !DateOf(to).Before(date); date = date.AddDays(1) {
+		begins, ends, working := config.workHours(date)
+		if !working {
+			continue
+		}
+
+		beginsAt := date.In(from.Location()).Add(begins)
+		endsAt := date.In(from.Location()).Add(ends)
+
+		if beginsAt.Before(from) {
+			beginsAt = from
+		}
+
+		if endsAt.After(to) {
+			endsAt = to
+		}
+
+		if endsAt.After(beginsAt) {
+			duration += endsAt.Sub(beginsAt)
+		}
+	}
+
+	return duration
+}
+
+//nolint:exhaustivestruct // do not check missing private member setting
+func indexTestConfig(indexFromYear, indexToYear int) Config {
+	return Config{
+		FirstWorkday:   FirstWorkdayDefault,
+		WorkdaysInWeek: WorkdaysInWeekDefault,
+		WorkBegins:     WorkBeginsDefault,
+		WorkEnds:       WorkEndsDefault,
+		TimeFormat:     TimeFormatDefault,
+		Holidays: []HolidayRule{
+			FixedHoliday{Name: "New Year", Month: time.January, Day: 1},
+			EasterHoliday{Name: "Easter Monday", Offset: 1},
+			PartialHoliday{
+				Rule:       FixedHoliday{Name: "Christmas Eve", Month: time.December, Day: 24},
+				WorkBegins: 9 * time.Hour,
+				WorkEnds:   13 * time.Hour,
+			},
+		},
+		Overrides: []DayOverride{
+			{Date: NewDate(2021, time.December, 11), Working: true, WorkBegins: 8 * time.Hour, WorkEnds: 12 * time.Hour},
+			{Date: NewDate(2022, time.March, 14), Working: false},
+		},
+		IndexFromYear: indexFromYear,
+		IndexToYear:   indexToYear,
+	}
+}
+
+func (s *WorkIndexTestSuite) TestWorkDuration() {
+	for _, indexYears := range [][2]int{{0, 0}, {2021, 2022}, {2019, 2025}} {
+		calendarTest, err := NewCalendar(indexTestConfig(indexYears[0], indexYears[1]))
+		s.Require().NoError(err)
+
+		random := rand.New(rand.NewSource(1)) //nolint:gosec // deterministic test data
+		start := parseTimeRfc3339("2020-06-01T00:00:00+02:00")
+
+		for n := 0; n < 200; n++ {
+			from := start.Add(time.Duration(random.Int63n(int64(2 * 365 * 24 * time.Hour))))
+			to := from.Add(time.Duration(random.Int63n(int64(3 * 365 * 24 * time.Hour))))
+
+			s.Require().Equal(
+				naiveWorkDuration(&calendarTest.config, from, to),
+				calendarTest.index.workDuration(from, to),
+				"%v: %s - %s", indexYears, from.Format(time.RFC3339), to.Format(time.RFC3339),
+			)
+		}
+	}
+}
+
+func (s *WorkIndexTestSuite) TestWorkTimeBefore() {
+	for _, indexYears := range [][2]int{{0, 0}, {2021, 2022}, {2019, 2025}} {
+		calendarTest, err := NewCalendar(indexTestConfig(indexYears[0], indexYears[1]))
+		s.Require().NoError(err)
+
+		random := rand.New(rand.NewSource(2)) //nolint:gosec // deterministic test data
+		start := parseTimeRfc3339("2021-06-01T00:00:00+02:00")
+
+		for n := 0; n < 200; n++ {
+			at := start.Add(time.Duration(random.Int63n(int64(2*365*24*time.Hour))) / time.Minute * time.Minute)
+			duration := time.Duration(random.Int63n(int64(3000*time.Hour))/int64(time.Minute)+1) * time.Minute
+
+			before := calendarTest.index.workTimeBefore(at, duration)
+
+			s.Require().Equal(
+				duration, naiveWorkDuration(&calendarTest.config, before, at),
+				"%v: %s - %s", indexYears, at.Format(time.RFC3339), duration,
+			)
+			s.Require().True(
+				calendarTest.IsWorkingTime(before.Add(-time.Nanosecond)),
+				"%v: %s - %s = %s", indexYears, at.Format(time.RFC3339), duration, before.Format(time.RFC3339),
+			)
+		}
+	}
+}
+
+//nolint:exhaustivestruct // do not check missing private member setting
+func (s *WorkIndexTestSuite) TestWeeksWithin() {
+	calendarTest, err := NewCalendar(Config{
+		FirstWorkday:   FirstWorkdayDefault,
+		WorkdaysInWeek: WorkdaysInWeekDefault,
+		WorkBegins:     WorkBeginsDefault,
+		WorkEnds:       WorkEndsDefault,
+		TimeFormat:     TimeFormatDefault,
+		Holidays: []HolidayRule{
+			FixedHoliday{Name: "Monday holiday", Month: time.November, Day: 1},
+		},
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		at       time.Time
+		duration time.Duration
+
+		expectedWeeks int
+	}{
+		{
+			name:          "Less than a week",
+			at:            parseTimeRfc3339("2021-10-13T09:30:00+04:00"),
+			duration:      HourToDuration(39),
+			expectedWeeks: 0,
+		},
+		{
+			name:          "A week",
+			at:            parseTimeRfc3339("2021-10-13T09:30:00+04:00"),
+			duration:      HourToDuration(40),
+			expectedWeeks: 1,
+		},
+		{
+			name:          "Shorter week",
+			at:            parseTimeRfc3339("2021-10-13T09:30:00+04:00"),
+			duration:      HourToDuration(40 + 40 + 32),
+			expectedWeeks: 3,
+		},
+		{
+			name:          "Many weeks",
+			at:            parseTimeRfc3339("2021-10-13T09:30:00+04:00"),
+			duration:      HourToDuration(40*100 - 2*8 + 39),
+			expectedWeeks: 100,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			weeks, err := calendarTest.index.weeksWithin(testCase.at, testCase.duration)
+			s.Require().NoError(err)
+
+			s.Assert().Equal(testCase.expectedWeeks, weeks)
+		})
+	}
+}
+
+func (s *WorkIndexTestSuite) TestWeeksWithinYears() {
+	calendarTest, err := NewCalendar(indexTestConfig(0, 0))
+	s.Require().NoError(err)
+
+	random := rand.New(rand.NewSource(3)) //nolint:gosec // deterministic test data
+	start := parseTimeRfc3339("2021-06-01T00:00:00+02:00")
+
+	for n := 0; n < 220; n++ {
+		at := start.Add(time.Duration(random.Int63n(int64(2*365*24*time.Hour))) / time.Minute * time.Minute)
+		maxDuration := 50000 * time.Hour
+
+		if n >= 200 {
+			// over 292 years, the longest time.Duration
+			maxDuration = 2000000 * time.Hour
+		}
+
+		duration := time.Duration(random.Int63n(int64(maxDuration))/int64(time.Minute)) * time.Minute
+
+		weeks, err := calendarTest.index.weeksWithin(at, duration)
+		s.Require().NoError(err)
+
+		s.Require().LessOrEqual(
+			int64(calendarTest.index.workDuration(at, addDays(at, weeks*daysPerWeek))), int64(duration),
+			"%s + %s: %d weeks", at.Format(time.RFC3339), duration, weeks,
+		)
+		s.Require().Greater(
+			int64(calendarTest.index.workDuration(at, addDays(at, (weeks+1)*daysPerWeek))), int64(duration),
+			"%s + %s: %d weeks", at.Format(time.RFC3339), duration, weeks,
+		)
+	}
+}
+
+func (s *WorkIndexTestSuite) TestYearsDuration() {
+	calendarTest, err := NewCalendar(indexTestConfig(0, 0))
+	s.Require().NoError(err)
+
+	random := rand.New(rand.NewSource(4)) //nolint:gosec // deterministic test data
+
+	for n := 0; n < 100; n++ {
+		from := 2000 + random.Intn(100)
+		to := from + random.Intn(30)
+
+		expected := time.Duration(0)
+		for year := from; year < to; year++ {
+			expected += naiveWorkDuration(&calendarTest.config,
+				NewDate(year, time.January, 1).In(time.UTC), NewDate(year+1, time.January, 1).In(time.UTC),
+			)
+		}
+
+		s.Require().Equal(expected, calendarTest.index.yearsDuration(from, to), "%d - %d", from, to)
+	}
+}
+
+func (s *WorkIndexTestSuite) TestProfileCache() {
+	calendarTest, err := NewCalendar(indexTestConfig(2021, 2022))
+	s.Require().NoError(err)
+
+	for _, testCase := range []struct {
+		year   int
+		cached bool
+	}{
+		{year: 2021, cached: true},
+		{year: 2022 + cacheMarginYears, cached: true},
+		{year: 2021 - cacheMarginYears, cached: true},
+		{year: 2023 + cacheMarginYears, cached: false},
+		{year: 1, cached: false},
+		{year: 9999, cached: false},
+	} {
+		s.Require().Equal(
+			naiveWorkDuration(&calendarTest.config,
+				NewDate(testCase.year, time.January, 1).In(time.UTC), NewDate(testCase.year+1, time.January, 1).In(time.UTC),
+			),
+			calendarTest.index.profile(testCase.year).total(), "%d", testCase.year,
+		)
+
+		_, cached := calendarTest.index.years.Load(testCase.year)
+		s.Assert().Equal(testCase.cached, cached, "%d", testCase.year)
+	}
+}
@@ -0,0 +1,142 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidDuration = errors.New("invalid ISO 8601 duration")
+
+//nolint:gochecknoglobals // read-only designators of the date and the time part, in order
+var isoDesignators = [2]string{"WD", "HMS"}
+
+/*
+ParseISODuration parses an ISO 8601 duration (for example "P2DT4H") as working time.
+A day (D) is the daily working time of the calendar, a week (W) is the working days of the week,
+hours (H), minutes (M) and seconds (S) are working time. Years and months aren't supported,
+because they have no working length. Only the last value may have fraction ('.' or ',').
+A leading '-' makes it negative.
+*/
+func (calendar *Calendar) ParseISODuration(value string) (time.Duration, error) {
+	text := value
+	sign := time.Duration(1)
+
+	if strings.HasPrefix(text, "-") {
+		text, sign = text[1:], -1
+	}
+
+	if !strings.HasPrefix(text, "P") {
+		return 0, fmt.Errorf("%w: %q, missing P", ErrInvalidDuration, value)
+	}
+
+	datePart, timePart, hasTime := text[1:], "", false
+	if t := strings.Index(datePart, "T"); t >= 0 {
+		datePart, timePart, hasTime = datePart[:t], datePart[t+1:], true
+	}
+
+	if datePart == "" && timePart == "" || hasTime && timePart == "" {
+		return 0, fmt.Errorf("%w: %q, missing value", ErrInvalidDuration, value)
+	}
+
+	duration := time.Duration(0)
+	fraction := false
+
+	for p, part := range [2]string{datePart, timePart} {
+		designators := isoDesignators[p]
+
+		for part != "" {
+			end := strings.IndexFunc(part, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+			if end <= 0 || fraction {
+				return 0, fmt.Errorf("%w: %q, invalid value", ErrInvalidDuration, value)
+			}
+
+			designator := strings.Index(designators, part[end:end+1])
+			if designator < 0 {
+				return 0, fmt.Errorf("%w: %q, unexpected %q", ErrInvalidDuration, value, part[end:end+1])
+			}
+
+			amount, err := strconv.ParseFloat(strings.Replace(part[:end], ",", ".", 1), 64)
+			if err != nil {
+				return 0, fmt.Errorf("%w: %q, invalid value", ErrInvalidDuration, value)
+			}
+
+			fraction = strings.ContainsAny(part[:end], ".,")
+
+			var fits bool
+			if duration, fits = addUnits(duration, amount, calendar.isoUnit(designators[designator])); !fits {
+				return 0, fmt.Errorf("%w: %q, too long", ErrInvalidDuration, value)
+			}
+
+			designators = designators[designator+1:]
+			part = part[end+1:]
+		}
+	}
+
+	return sign * duration, nil
+}
+
+func (calendar *Calendar) isoUnit(designator byte) time.Duration {
+	switch designator {
+	case 'W':
+		return time.Duration(calendar.config.WorkdaysInWeek) * calendar.DailyWorkDuration()
+	case 'D':
+		return calendar.DailyWorkDuration()
+	case 'H':
+		return time.Hour
+	case 'M':
+		return time.Minute
+	default:
+		return time.Second
+	}
+}
+
+// FormatISODuration formats the working time as ISO 8601 duration in days (D) and time, see ParseISODuration.
+func (calendar *Calendar) FormatISODuration(duration time.Duration) string {
+	builder := strings.Builder{}
+
+	if duration < 0 {
+		builder.WriteString("-")
+
+		duration = -duration
+	}
+
+	builder.WriteString("P")
+
+	days := duration / calendar.DailyWorkDuration()
+	if days > 0 {
+		fmt.Fprintf(&builder, "%dD", days)
+
+		duration -= days * calendar.DailyWorkDuration()
+	}
+
+	if duration == 0 {
+		if days == 0 {
+			builder.WriteString("T0S")
+		}
+
+		return builder.String()
+	}
+
+	builder.WriteString("T")
+
+	if hours := duration / time.Hour; hours > 0 {
+		fmt.Fprintf(&builder, "%dH", hours)
+
+		duration -= hours * time.Hour
+	}
+
+	if minutes := duration / time.Minute; minutes > 0 {
+		fmt.Fprintf(&builder, "%dM", minutes)
+
+		duration -= minutes * time.Minute
+	}
+
+	if duration > 0 {
+		fmt.Fprintf(&builder, "%sS", strconv.FormatFloat(duration.Seconds(), 'f', -1, 64))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,228 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Locale selects the language of the messages and the weekday names.
+type Locale string
+
+const (
+	LocaleEnglish   Locale = "en"
+	LocaleHungarian Locale = "hu"
+	LocaleGerman    Locale = "de"
+)
+
+// catalogue is the messages of a locale. The formats of submit time errors get the submit time first.
+type catalogue struct {
+	weekdays [daysPerWeek]string
+	// timeFormat is the layout of the times in the messages, empty means Config.TimeFormat
+	timeFormat string
+	errors     map[error]string
+
+	dayOff    string
+	weekday   string
+	holiday   string
+	workHours string
+
+	// override, week and weeks are the notes of the trace steps
+	override string
+	week     string
+	weeks    string
+	// workingDay and workingDays are the units of FormatWorkingDurationLocale
+	workingDay  string
+	workingDays string
+}
+
+//nolint:gochecknoglobals // read-only list of the translated errors
+var localizedErrors = []error{
+	ErrInvalidWorkdays, ErrInvalidWorkTime, ErrInvalidSubmitTime, ErrInvalidTimeFormat, ErrInvalidHoliday,
+	ErrInvalidOverride, ErrInvalidIndexYears, ErrInvalidTimeString, ErrInvalidTimeline, ErrTimelinePaused,
+	ErrInvalidFraction, ErrInvalidPhrase, ErrAmbiguousPhrase, ErrInvalidDuration,
+	ErrInvalidWorkingDuration, ErrInvalidTurnaround, ErrNilCalendar,
+}
+
+//nolint:gochecknoglobals // read-only message catalogues
+var catalogues = map[Locale]*catalogue{
+	LocaleEnglish: {
+		weekdays: [daysPerWeek]string{
+			"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+		},
+		timeFormat: "",
+		errors:     map[error]string{},
+		dayOff:     "%s, %s is not a workday",
+		weekday:    "%s, must be %s - %s",
+		holiday:    "%s, %s is a holiday",
+		workHours:  "%s, must be %s - %s",
+
+		override:    "override",
+		week:        "1 week",
+		weeks:       "%d weeks",
+		workingDay:  "working day",
+		workingDays: "working days",
+	},
+	LocaleHungarian: {
+		weekdays: [daysPerWeek]string{
+			"vasárnap", "hétfő", "kedd", "szerda", "csütörtök", "péntek", "szombat",
+		},
+		timeFormat: "2006.01.02. 15:04",
+		errors: map[error]string{
+			ErrInvalidWorkdays:        "érvénytelen munkanapok",
+			ErrInvalidWorkTime:        "érvénytelen munkaidő",
+			ErrInvalidSubmitTime:      "érvénytelen beküldési időpont",
+			ErrInvalidTimeFormat:      "érvénytelen időformátum",
+			ErrInvalidHoliday:         "érvénytelen ünnepnap-szabály",
+			ErrInvalidOverride:        "érvénytelen munkanap-módosítás",
+			ErrInvalidIndexYears:      "érvénytelen index évek",
+			ErrInvalidTimeString:      "érvénytelen időpont",
+			ErrInvalidTimeline:        "érvénytelen idővonal",
+			ErrTimelinePaused:         "az idővonal szüneteltetve van",
+			ErrInvalidFraction:        "érvénytelen arány",
+			ErrInvalidPhrase:          "érvénytelen kifejezés",
+			ErrAmbiguousPhrase:        "többértelmű kifejezés",
+			ErrInvalidDuration:        "érvénytelen ISO 8601 időtartam",
+			ErrInvalidWorkingDuration: "érvénytelen munkaidő-tartam",
+			ErrInvalidTurnaround:      "érvénytelen átfutási idő",
+			ErrNilCalendar:            "hiányzó naptár",
+		},
+		dayOff:    "%s, %s nem munkanap",
+		weekday:   "%s, %s és %s között kell lennie",
+		holiday:   "%s, %s ünnepnap",
+		workHours: "%s, %s és %s között kell lennie",
+
+		override:    "munkanap-módosítás",
+		week:        "1 hét",
+		weeks:       "%d hét",
+		workingDay:  "munkanap",
+		workingDays: "munkanap",
+	},
+	LocaleGerman: {
+		weekdays: [daysPerWeek]string{
+			"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag",
+		},
+		timeFormat: "02.01.2006 15:04",
+		errors: map[error]string{
+			ErrInvalidWorkdays:        "ungültige Arbeitstage",
+			ErrInvalidWorkTime:        "ungültige Arbeitszeit",
+			ErrInvalidSubmitTime:      "ungültiger Einreichungszeitpunkt",
+			ErrInvalidTimeFormat:      "ungültiges Zeitformat",
+			ErrInvalidHoliday:         "ungültige Feiertagsregel",
+			ErrInvalidOverride:        "ungültige Tagesänderung",
+			ErrInvalidIndexYears:      "ungültige Indexjahre",
+			ErrInvalidTimeString:      "ungültige Zeitangabe",
+			ErrInvalidTimeline:        "ungültige Zeitleiste",
+			ErrTimelinePaused:         "Zeitleiste ist pausiert",
+			ErrInvalidFraction:        "ungültiger Anteil",
+			ErrInvalidPhrase:          "ungültiger Ausdruck",
+			ErrAmbiguousPhrase:        "mehrdeutiger Ausdruck",
+			ErrInvalidDuration:        "ungültige ISO-8601-Dauer",
+			ErrInvalidWorkingDuration: "ungültige Arbeitsdauer",
+			ErrInvalidTurnaround:      "ungültige Bearbeitungszeit",
+			ErrNilCalendar:            "fehlender Kalender",
+		},
+		dayOff:    "%s, %s ist kein Arbeitstag",
+		weekday:   "%s, muss zwischen %s und %s liegen",
+		holiday:   "%s, %s ist ein Feiertag",
+		workHours: "%s, muss zwischen %s und %s liegen",
+
+		override:    "Tagesänderung",
+		week:        "1 Woche",
+		weeks:       "%d Wochen",
+		workingDay:  "Arbeitstag",
+		workingDays: "Arbeitstage",
+	},
+}
+
+// ParseLocale returns the locale of a language tag, for example "hu-HU". The second value is false, if unsupported.
+func ParseLocale(tag string) (Locale, bool) {
+	language := strings.ToLower(strings.SplitN(strings.SplitN(strings.TrimSpace(tag), "-", 2)[0], "_", 2)[0])
+	if _, has := catalogues[Locale(language)]; has {
+		return Locale(language), true
+	}
+
+	return LocaleEnglish, false
+}
+
+// catalogue returns the messages of the locale, English if unsupported.
+func (locale Locale) catalogue() *catalogue {
+	if messages, has := catalogues[locale]; has {
+		return messages
+	}
+
+	return catalogues[LocaleEnglish]
+}
+
+func (locale Locale) Weekday(weekday time.Weekday) string {
+	return locale.catalogue().weekdays[weekday]
+}
+
+// FormatTime formats the time by the layout of the locale, or by layout, if the locale has no own one.
+func (locale Locale) FormatTime(at time.Time, layout string) string {
+	if locale.catalogue().timeFormat != "" {
+		layout = locale.catalogue().timeFormat
+	}
+
+	return at.Format(layout)
+}
+
+// Message returns the message of the error in the language of the locale.
+// A SubmitTimeError is translated fully, other errors of the package are translated by their sentinel errors.
+func (locale Locale) Message(err error) string {
+	messages := locale.catalogue()
+
+	var submitTimeError *SubmitTimeError
+	if errors.As(err, &submitTimeError) {
+		return locale.submitTimeMessage(submitTimeError)
+	}
+
+	for _, sentinel := range localizedErrors {
+		title, has := messages.errors[sentinel]
+		if !has || !errors.Is(err, sentinel) {
+			continue
+		}
+
+		if message := err.Error(); strings.HasPrefix(message, sentinel.Error()) {
+			return title + message[len(sentinel.Error()):]
+		}
+
+		return title + ": " + err.Error()
+	}
+
+	return err.Error()
+}
+
+func (locale Locale) submitTimeMessage(err *SubmitTimeError) string {
+	messages := locale.catalogue()
+	title := ErrInvalidSubmitTime.Error()
+
+	if translated, has := messages.errors[ErrInvalidSubmitTime]; has {
+		title = translated
+	}
+
+	submitAt := locale.FormatTime(err.SubmitAt, err.timeFormat)
+
+	var message string
+
+	switch err.Reason {
+	case ReasonDayOff:
+		message = fmt.Sprintf(messages.dayOff, submitAt, DateOf(err.SubmitAt))
+	case ReasonWeekday:
+		message = fmt.Sprintf(
+			messages.weekday, submitAt, locale.Weekday(err.FirstWorkday), locale.Weekday(err.LastWorkday),
+		)
+	case ReasonHoliday:
+		message = fmt.Sprintf(messages.holiday, submitAt, err.Holiday)
+	case ReasonWorkHours:
+		message = fmt.Sprintf(
+			messages.workHours, submitAt,
+			locale.FormatTime(err.WindowStart, err.timeFormat), locale.FormatTime(err.WindowEnd, err.timeFormat),
+		)
+	default:
+		message = submitAt
+	}
+
+	return title + ": " + message
+}
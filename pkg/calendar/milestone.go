@@ -0,0 +1,40 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var ErrInvalidFraction = errors.New("invalid fraction")
+
+// CalculateMilestones returns the times, when the fractions of the turnaround elapse, in the order of fractions.
+// For example, 0.5 is the half of the turnaround, and 1 is the due date, see CalculateDueDate.
+func (calendar *Calendar) CalculateMilestones(
+	submitAt time.Time, turnaroundDurationHour float64, fractions []float64,
+) ([]time.Time, error) {
+	if err := ValidateTurnaroundHours(turnaroundDurationHour); err != nil {
+		return nil, err
+	}
+
+	turnaround := HourToDuration(turnaroundDurationHour)
+	milestones := make([]time.Time, 0, len(fractions))
+
+	for _, fraction := range fractions {
+		// rounded like HourToDuration, NaN is rejected by the comparisons
+		elapsed := math.Round(fraction * float64(turnaround))
+		if !(fraction >= 0) || math.IsInf(fraction, 0) || !(elapsed < math.MaxInt64) {
+			return nil, fmt.Errorf("%w: %f", ErrInvalidFraction, fraction)
+		}
+
+		milestone, err := calendar.calculateDueDate(submitAt, time.Duration(elapsed))
+		if err != nil {
+			return nil, err
+		}
+
+		milestones = append(milestones, milestone)
+	}
+
+	return milestones, nil
+}
@@ -0,0 +1,97 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// DayOverride changes a single date to a working day (for example a make-up Saturday)
+// or to a non-working day (for example a bridge day).
+// If both WorkBegins and WorkEnds are zero, a working day has the hours of the Config.
+type DayOverride struct {
+	Date       Date
+	Working    bool
+	WorkBegins time.Duration
+	WorkEnds   time.Duration
+}
+
+func validateOverrides(overrides []DayOverride) error {
+	dates := map[Date]bool{}
+
+	for _, override := range overrides {
+		if dates[override.Date] {
+			return fmt.Errorf("%w: %s is duplicated", ErrInvalidOverride, override.Date)
+		}
+
+		dates[override.Date] = true
+
+		if !override.Working || (override.WorkBegins == 0 && override.WorkEnds == 0) {
+			continue
+		}
+
+		if override.WorkBegins < 0 || override.WorkEnds > hoursPerDay*time.Hour ||
+			override.WorkBegins >= override.WorkEnds {
+			return fmt.Errorf(
+				"%w: %s, %s - %s",
+				ErrInvalidOverride, override.Date, override.WorkBegins.String(), override.WorkEnds.String(),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (config *Config) override(date Date) (DayOverride, bool) {
+	for _, override := range config.Overrides {
+		if override.Date == date {
+			if override.Working && override.WorkBegins == 0 && override.WorkEnds == 0 {
+				override.WorkBegins = config.WorkBegins
+				override.WorkEnds = config.WorkEnds
+			}
+
+			return override, true
+		}
+	}
+
+	return DayOverride{}, false
+}
+
+// workHours returns the working hours of the date, applying the overrides, the holidays and the weekly pattern.
+func (config *Config) workHours(date Date) (time.Duration, time.Duration, bool) {
+	holiday, isHoliday := config.holiday(date)
+
+	return config.dayWorkHours(date, holiday, isHoliday)
+}
+
+func (config *Config) dayWorkHours(date Date, holiday Holiday, isHoliday bool) (time.Duration, time.Duration, bool) {
+	if override, is := config.override(date); is {
+		return override.WorkBegins, override.WorkEnds, override.Working
+	}
+
+	if !config.isWeeklyWorkday(date.Weekday()) {
+		return 0, 0, false
+	}
+
+	if isHoliday {
+		return holiday.WorkBegins, holiday.WorkEnds, holiday.IsPartial()
+	}
+
+	return config.WorkBegins, config.WorkEnds, true
+}
+
+// IsWorkday reports whether the date has working hours.
+func (calendar *Calendar) IsWorkday(date Date) bool {
+	_, _, working := calendar.index.workHours(date)
+
+	return working
+}
+
+// WorkHours returns the beginning and the end of the working hours on the date, measured from midnight.
+func (calendar *Calendar) WorkHours(date Date) (time.Duration, time.Duration, bool) {
+	return calendar.index.workHours(date)
+}
+
+// Override returns the override of the date. A working override without hours has the hours of the Config.
+func (calendar *Calendar) Override(date Date) (DayOverride, bool) {
+	return calendar.config.override(date)
+}
@@ -0,0 +1,364 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	ErrInvalidPhrase   = errors.New("invalid phrase")
+	ErrAmbiguousPhrase = errors.New("ambiguous phrase")
+)
+
+// Phrase is a parsed natural-language request, see ParsePhrase.
+type Phrase struct {
+	SubmitAt   time.Time
+	Turnaround time.Duration
+}
+
+const (
+	hoursPerHalfDay = 12
+	minutesPerHour  = 60
+	phraseEnd       = ""
+)
+
+//nolint:gochecknoglobals // read-only grammar tables
+var (
+	phraseWeekdays = map[string]time.Weekday{
+		"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+		"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+	}
+	phraseClockUnits = map[string]time.Duration{
+		"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+		"h": time.Hour, "hr": time.Hour, "hrs": time.Hour, "hour": time.Hour, "hours": time.Hour,
+	}
+	phraseAmbiguousUnits = map[string]bool{
+		"d": true, "day": true, "days": true, "w": true, "week": true, "weeks": true,
+	}
+)
+
+/*
+ParsePhrase parses a natural-language submit time and turnaround, relative to now.
+The words are case-insensitive, the grammar is strict:
+
+	phrase   = "in" duration | duration "from" moment | moment [ "+" duration ]
+	moment   = "now" | ( "today" | "tomorrow" | [ "next" ] weekday ) [ [ "at" ] clock ]
+	clock    = H ( "am" | "pm" ) | H:MM [ "am" | "pm" ]
+	duration = amount unit { amount unit }
+	unit     = minutes | hours | "workday" | "workdays"
+	         | ( "business" | "working" ) ( "day" | "days" | "week" | "weeks" )
+
+For example "3 business days from now" or "next Tuesday 10am + 4h".
+A business day is the daily working time, a business week is the working days of the week.
+A day without clock means its first working time, but not before now. A bare weekday is the next one,
+ErrAmbiguousPhrase is returned on the same weekday, or for a bare hour and calendar days or weeks.
+The times are in Config.Location, or in the location of now, if not set.
+*/
+func (calendar *Calendar) ParsePhrase(text string, now time.Time) (Phrase, error) {
+	tokens, err := tokenizePhrase(text)
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	if calendar.config.Location != nil {
+		now = now.In(calendar.config.Location)
+	}
+
+	parser := phraseParser{calendar: calendar, tokens: tokens, pos: 0, now: now}
+
+	phrase, err := parser.parsePhrase()
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	if token := parser.peek(); token != phraseEnd {
+		return Phrase{}, fmt.Errorf("%w: unexpected %q", ErrInvalidPhrase, token)
+	}
+
+	return phrase, nil
+}
+
+// CalculatePhraseDueDate returns the due date of the phrase, see ParsePhrase.
+// The submit time may be off the working hours, then the turnaround starts at the next working time.
+func (calendar *Calendar) CalculatePhraseDueDate(text string, now time.Time) (time.Time, error) {
+	phrase, err := calendar.ParsePhrase(text, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return calendar.addWorkingDuration(phrase.SubmitAt, phrase.Turnaround)
+}
+
+// tokenizePhrase splits the text to words, numbers (with ':' and '.') and '+' signs, for example "10am+4h".
+func tokenizePhrase(text string) ([]string, error) {
+	tokens := []string{}
+	runes := []rune(strings.ToLower(text))
+
+	for pos := 0; pos < len(runes); {
+		start := pos
+
+		switch {
+		case unicode.IsSpace(runes[pos]):
+			pos++
+
+			continue
+		case runes[pos] == '+':
+			pos++
+		case unicode.IsLetter(runes[pos]):
+			for pos < len(runes) && unicode.IsLetter(runes[pos]) {
+				pos++
+			}
+		case unicode.IsDigit(runes[pos]):
+			for pos < len(runes) && (unicode.IsDigit(runes[pos]) || runes[pos] == ':' || runes[pos] == '.') {
+				pos++
+			}
+		default:
+			return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidPhrase, runes[pos])
+		}
+
+		tokens = append(tokens, string(runes[start:pos]))
+	}
+
+	return tokens, nil
+}
+
+type phraseParser struct {
+	calendar *Calendar
+	tokens   []string
+	pos      int
+	now      time.Time
+}
+
+func (parser *phraseParser) peek() string {
+	if parser.pos >= len(parser.tokens) {
+		return phraseEnd
+	}
+
+	return parser.tokens[parser.pos]
+}
+
+func (parser *phraseParser) next() string {
+	token := parser.peek()
+	if token != phraseEnd {
+		parser.pos++
+	}
+
+	return token
+}
+
+func (parser *phraseParser) expect(expected string) error {
+	if token := parser.next(); token != expected {
+		return unexpectedToken(token, expected)
+	}
+
+	return nil
+}
+
+func unexpectedToken(token string, expected string) error {
+	if token == phraseEnd {
+		return fmt.Errorf("%w: unexpected end, expected %s", ErrInvalidPhrase, expected)
+	}
+
+	return fmt.Errorf("%w: unexpected %q, expected %s", ErrInvalidPhrase, token, expected)
+}
+
+func isNumber(token string) bool {
+	return token != phraseEnd && unicode.IsDigit([]rune(token)[0])
+}
+
+func (parser *phraseParser) parsePhrase() (Phrase, error) {
+	switch {
+	case parser.peek() == "in":
+		parser.next()
+
+		turnaround, err := parser.parseDuration()
+
+		return Phrase{SubmitAt: parser.now, Turnaround: turnaround}, err
+	case isNumber(parser.peek()):
+		turnaround, err := parser.parseDuration()
+		if err != nil {
+			return Phrase{}, err
+		}
+
+		if err := parser.expect("from"); err != nil {
+			return Phrase{}, err
+		}
+
+		submitAt, err := parser.parseMoment()
+
+		return Phrase{SubmitAt: submitAt, Turnaround: turnaround}, err
+	default:
+		submitAt, err := parser.parseMoment()
+		if err != nil || parser.peek() != "+" {
+			return Phrase{SubmitAt: submitAt, Turnaround: 0}, err
+		}
+
+		parser.next()
+
+		turnaround, err := parser.parseDuration()
+
+		return Phrase{SubmitAt: submitAt, Turnaround: turnaround}, err
+	}
+}
+
+func (parser *phraseParser) parseMoment() (time.Time, error) {
+	days := 0
+	token := parser.next()
+
+	switch token {
+	case "now":
+		return parser.now, nil
+	case "today":
+	case "tomorrow":
+		days = 1
+	case "next":
+		token = parser.next()
+
+		weekday, has := phraseWeekdays[token]
+		if !has {
+			return time.Time{}, unexpectedToken(token, "weekday")
+		}
+
+		days = (int(weekday) - int(parser.now.Weekday()) + daysPerWeek) % daysPerWeek
+		switch days {
+		case 0:
+			days = daysPerWeek
+		case 1:
+			return time.Time{}, fmt.Errorf("%w: \"next %s\" is tomorrow or next week, use \"tomorrow\"",
+				ErrAmbiguousPhrase, token)
+		default:
+		}
+	default:
+		weekday, has := phraseWeekdays[token]
+		if !has {
+			return time.Time{}, unexpectedToken(token, "now, today, tomorrow or weekday")
+		}
+
+		days = (int(weekday) - int(parser.now.Weekday()) + daysPerWeek) % daysPerWeek
+		if days == 0 {
+			return time.Time{}, fmt.Errorf("%w: %q is today or next week, use \"today\" or \"next\"",
+				ErrAmbiguousPhrase, token)
+		}
+	}
+
+	year, month, day := parser.now.Date()
+	date := time.Date(year, month, day+days, 0, 0, 0, 0, parser.now.Location())
+
+	if parser.peek() == "at" {
+		parser.next()
+
+		if !isNumber(parser.peek()) {
+			return time.Time{}, unexpectedToken(parser.peek(), "clock")
+		}
+	}
+
+	if !isNumber(parser.peek()) {
+		if date.Before(parser.now) {
+			date = parser.now
+		}
+
+		return parser.calendar.index.nextWorkTime(date)
+	}
+
+	hour, minute, err := parser.parseClock()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(year, month, day+days, hour, minute, 0, 0, parser.now.Location()), nil
+}
+
+func (parser *phraseParser) parseClock() (int, int, error) {
+	token := parser.next()
+	hourText, minuteText := token, "0"
+	colon := strings.Index(token, ":")
+
+	if colon >= 0 {
+		hourText, minuteText = token[:colon], token[colon+1:]
+	}
+
+	hour, errHour := strconv.Atoi(hourText)
+	minute, errMinute := strconv.Atoi(minuteText)
+
+	if errHour != nil || errMinute != nil || minute >= minutesPerHour || hour >= hoursPerDay {
+		return 0, 0, fmt.Errorf("%w: invalid clock %q", ErrInvalidPhrase, token)
+	}
+
+	switch parser.peek() {
+	case "am", "pm":
+		if hour < 1 || hour > hoursPerHalfDay {
+			return 0, 0, fmt.Errorf("%w: invalid clock %q", ErrInvalidPhrase, token)
+		}
+
+		hour %= hoursPerHalfDay
+		if parser.next() == "pm" {
+			hour += hoursPerHalfDay
+		}
+	default:
+		if colon < 0 {
+			return 0, 0, fmt.Errorf("%w: %q is hour or amount, use am, pm or H:MM", ErrAmbiguousPhrase, token)
+		}
+	}
+
+	return hour, minute, nil
+}
+
+func (parser *phraseParser) parseDuration() (time.Duration, error) {
+	duration := time.Duration(0)
+
+	for {
+		token := parser.next()
+
+		amount, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			return 0, unexpectedToken(token, "amount")
+		}
+
+		unit, err := parser.parseUnit()
+		if err != nil {
+			return 0, err
+		}
+
+		var fits bool
+		if duration, fits = addUnits(duration, amount, unit); !fits {
+			return 0, fmt.Errorf("%w: %q is too long", ErrInvalidPhrase, token)
+		}
+
+		if !isNumber(parser.peek()) {
+			return duration, nil
+		}
+	}
+}
+
+func (parser *phraseParser) parseUnit() (time.Duration, error) {
+	token := parser.next()
+
+	if unit, has := phraseClockUnits[token]; has {
+		return unit, nil
+	}
+
+	if phraseAmbiguousUnits[token] {
+		return 0, fmt.Errorf("%w: %q is calendar or business time, use business days or weeks",
+			ErrAmbiguousPhrase, token)
+	}
+
+	switch token {
+	case "workday", "workdays":
+		return parser.calendar.DailyWorkDuration(), nil
+	case "business", "working":
+		switch token = parser.next(); token {
+		case "day", "days":
+			return parser.calendar.DailyWorkDuration(), nil
+		case "week", "weeks":
+			return time.Duration(parser.calendar.config.WorkdaysInWeek) * parser.calendar.DailyWorkDuration(), nil
+		default:
+			return 0, unexpectedToken(token, "days or weeks")
+		}
+	default:
+		return 0, unexpectedToken(token, "unit")
+	}
+}
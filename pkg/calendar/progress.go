@@ -0,0 +1,94 @@
+package calendar
+
+import (
+	"time"
+)
+
+// Status is the state of a turnaround at a given time.
+type Status int
+
+const (
+	StatusOnTrack Status = iota
+	StatusAtRisk
+	StatusBreached
+)
+
+const (
+	// AtRiskPercentDefault is the consumed percent of the turnaround, from which it's at risk.
+	AtRiskPercentDefault = 80.0
+
+	percent = 100
+)
+
+func (status Status) String() string {
+	switch status {
+	case StatusOnTrack:
+		return "on-track"
+	case StatusAtRisk:
+		return "at-risk"
+	case StatusBreached:
+		return "breached"
+	default:
+		return "unknown"
+	}
+}
+
+func (status Status) MarshalText() ([]byte, error) {
+	return []byte(status.String()), nil
+}
+
+// Progress is the state of a turnaround at a given time.
+// Remaining is negative, if the turnaround is exceeded. PercentConsumed may be more than 100.
+type Progress struct {
+	Turnaround      time.Duration `json:"turnaround"`
+	Consumed        time.Duration `json:"consumed"`
+	Remaining       time.Duration `json:"remaining"`
+	PercentConsumed float64       `json:"percentConsumed"`
+	Status          Status        `json:"status"`
+}
+
+// CalculateProgress returns the progress of the turnaround at the given time, see CalculateTimelineProgress.
+func (calendar *Calendar) CalculateProgress(
+	submitAt time.Time, turnaroundDurationHour float64, at time.Time, atRiskPercent float64,
+) (Progress, error) {
+	return calendar.CalculateTimelineProgress(
+		Timeline{SubmitAt: submitAt, Pauses: nil}, turnaroundDurationHour, at, atRiskPercent,
+	)
+}
+
+// CalculateTimelineProgress returns the progress of the turnaround at the given time, not counting the paused periods.
+// The turnaround is at risk from atRiskPercent consumed, and breached, if no working time remained.
+func (calendar *Calendar) CalculateTimelineProgress(
+	timeline Timeline, turnaroundDurationHour float64, at time.Time, atRiskPercent float64,
+) (Progress, error) {
+	if err := ValidateTurnaroundHours(turnaroundDurationHour); err != nil {
+		return Progress{}, err
+	}
+
+	consumed, err := calendar.TimelineWorkingDuration(timeline, at)
+	if err != nil {
+		return Progress{}, err
+	}
+
+	progress := Progress{
+		Turnaround:      HourToDuration(turnaroundDurationHour),
+		Consumed:        consumed,
+		Remaining:       HourToDuration(turnaroundDurationHour) - consumed,
+		PercentConsumed: percent,
+		Status:          StatusOnTrack,
+	}
+
+	if progress.Turnaround > 0 {
+		progress.PercentConsumed = float64(progress.Consumed) / float64(progress.Turnaround) * percent
+	}
+
+	switch {
+	case progress.Remaining <= 0:
+		progress.Status = StatusBreached
+	case progress.PercentConsumed >= atRiskPercent:
+		progress.Status = StatusAtRisk
+	default:
+	}
+
+	return progress, nil
+}
@@ -0,0 +1,101 @@
+package calendar
+
+import "time"
+
+/*
+ReferenceDueDate calculates the due date like CalculateDueDate, walking minute by minute through the working time.
+It's slow, but simple, so it's the reference to verify CalculateDueDate.
+The working hours are evaluated by the config rules, not by the working-time index of CalculateDueDate.
+A step ends at the beginning or the end of the working hours, if it's before the next minute.
+The due date at the end of the working hours is the end,
+where CalculateDueDate may return the beginning of the next working hours, which is the same working time.
+*/
+func (calendar *Calendar) ReferenceDueDate(submitAt time.Time, turnaroundDurationHour float64) (time.Time, error) {
+	if err := ValidateTurnaroundHours(turnaroundDurationHour); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := calendar.validateSubmitTime(submitAt); err != nil {
+		return time.Time{}, err
+	}
+
+	at := submitAt
+	workingTime := calendar.referenceWorkingTime()
+
+	for remaining := HourToDuration(turnaroundDurationHour); remaining > 0; {
+		working, next := workingTime(at)
+		step := referenceStep(at, next, remaining)
+
+		if working {
+			remaining -= step
+		}
+
+		at = at.Add(step)
+	}
+
+	return at, nil
+}
+
+// ReferenceWorkingDuration is WorkingDurationBetween, walking minute by minute. See ReferenceDueDate.
+func (calendar *Calendar) ReferenceWorkingDuration(from, to time.Time) time.Duration {
+	if to.Before(from) {
+		return -calendar.ReferenceWorkingDuration(to, from)
+	}
+
+	duration := time.Duration(0)
+	workingTime := calendar.referenceWorkingTime()
+
+	for at := from; at.Before(to); {
+		working, next := workingTime(at)
+		step := referenceStep(at, next, to.Sub(at))
+
+		if working {
+			duration += step
+		}
+
+		at = at.Add(step)
+	}
+
+	return duration
+}
+
+// referenceWorkingTime returns a function like IsWorkingTime, evaluating the working hours by the config rules.
+// The function returns the next time, where it may change, too: the next minute, or the beginning or the end
+// of the working hours or the day, if it's earlier. The working hours and the boundaries of the last date are kept,
+// because the times are walked minute by minute.
+func (calendar *Calendar) referenceWorkingTime() func(at time.Time) (bool, time.Time) {
+	lastDate := Date{}
+	working := false
+	boundaries := [3]time.Time{}
+
+	return func(at time.Time) (bool, time.Time) {
+		if date := DateOf(at); date != lastDate {
+			var begins, ends time.Duration
+
+			lastDate = date
+			begins, ends, working = calendar.config.workHours(date)
+			boundaries = [3]time.Time{
+				calculateDayTime(at, begins), calculateDayTime(at, ends), date.AddDays(1).In(at.Location()),
+			}
+		}
+
+		next := at.Truncate(time.Minute).Add(time.Minute)
+
+		for _, boundary := range boundaries {
+			if boundary.After(at) && boundary.Before(next) {
+				next = boundary
+			}
+		}
+
+		return working && !at.Before(boundaries[0]) && at.Before(boundaries[1]), next
+	}
+}
+
+// referenceStep returns the duration till next, but not more than limit.
+func referenceStep(at, next time.Time, limit time.Duration) time.Duration {
+	if step := next.Sub(at); step < limit {
+		return step
+	}
+
+	return limit
+}
@@ -0,0 +1,33 @@
+package calendar
+
+import "time"
+
+// TestReferenceWithoutIndex checks, that the reference doesn't depend on the working-time index.
+func (s *CalendarTestSuite) TestReferenceWithoutIndex() {
+	config := Config{
+		FirstWorkday:   FirstWorkdayDefault,
+		WorkdaysInWeek: WorkdaysInWeekDefault,
+		WorkBegins:     WorkBeginsDefault,
+		WorkEnds:       WorkEndsDefault,
+		TimeFormat:     TimeFormatDefault,
+		Holidays:       []HolidayRule{FixedHoliday{Name: "Holiday", Month: time.November, Day: 3}},
+		Overrides:      []DayOverride{{Date: NewDate(2021, time.November, 6), Working: true}},
+	}
+
+	calendarTest, err := NewCalendar(config)
+	s.Require().NoError(err)
+
+	// the index of the calendar without the holidays and the overrides
+	config.Holidays, config.Overrides = nil, nil
+	calendarTest.index = newWorkIndex(config)
+
+	submitAt := parseTimeRfc3339("2021-11-02T16:00:00+01:00")
+
+	dueAt, err := calendarTest.ReferenceDueDate(submitAt, 2)
+	s.Require().NoError(err)
+	s.Assert().Equal(parseTimeRfc3339("2021-11-04T10:00:00+01:00"), dueAt)
+
+	s.Assert().Equal(25*time.Hour,
+		calendarTest.ReferenceWorkingDuration(submitAt, parseTimeRfc3339("2021-11-08T09:00:00+01:00")),
+	)
+}
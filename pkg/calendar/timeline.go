@@ -0,0 +1,210 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidTimeline = errors.New("invalid timeline")
+	ErrTimelinePaused  = errors.New("timeline is paused")
+)
+
+// Timeline is the history of a ticket: its submission and the pauses of its turnaround,
+// for example while waiting for the customer. A paused period doesn't consume turnaround.
+type Timeline struct {
+	SubmitAt time.Time
+	Pauses   []Pause
+}
+
+// Pause is a paused period. ResumedAt is zero, while it's paused.
+type Pause struct {
+	PausedAt  time.Time
+	ResumedAt time.Time
+}
+
+func NewTimeline(submitAt time.Time) *Timeline {
+	return &Timeline{
+		SubmitAt: submitAt,
+		Pauses:   []Pause{},
+	}
+}
+
+// Pause stops the turnaround at the given time.
+func (timeline *Timeline) Pause(at time.Time) error {
+	if timeline.IsPaused() {
+		return fmt.Errorf("%w: already paused", ErrInvalidTimeline)
+	}
+
+	if at.Before(timeline.lastEventAt()) {
+		return fmt.Errorf("%w: pause at %s is before the last event", ErrInvalidTimeline, at.Format(time.RFC3339))
+	}
+
+	timeline.Pauses = append(timeline.Pauses, Pause{PausedAt: at, ResumedAt: time.Time{}})
+
+	return nil
+}
+
+// Resume continues the turnaround at the given time.
+func (timeline *Timeline) Resume(at time.Time) error {
+	if !timeline.IsPaused() {
+		return fmt.Errorf("%w: not paused", ErrInvalidTimeline)
+	}
+
+	if at.Before(timeline.lastEventAt()) {
+		return fmt.Errorf("%w: resume at %s is before the pause", ErrInvalidTimeline, at.Format(time.RFC3339))
+	}
+
+	timeline.Pauses[len(timeline.Pauses)-1].ResumedAt = at
+
+	return nil
+}
+
+func (timeline *Timeline) IsPaused() bool {
+	return len(timeline.Pauses) > 0 && timeline.Pauses[len(timeline.Pauses)-1].ResumedAt.IsZero()
+}
+
+func (timeline *Timeline) lastEventAt() time.Time {
+	if len(timeline.Pauses) == 0 {
+		return timeline.SubmitAt
+	}
+
+	if pause := timeline.Pauses[len(timeline.Pauses)-1]; !pause.ResumedAt.IsZero() {
+		return pause.ResumedAt
+	}
+
+	return timeline.Pauses[len(timeline.Pauses)-1].PausedAt
+}
+
+// validate checks the order of the events, because Pauses can be set directly, too.
+func (timeline *Timeline) validate() error {
+	lastEventAt := timeline.SubmitAt
+
+	for p, pause := range timeline.Pauses {
+		if pause.PausedAt.Before(lastEventAt) {
+			return fmt.Errorf("%w: pause #%d is before the previous event", ErrInvalidTimeline, p)
+		}
+
+		if pause.ResumedAt.IsZero() {
+			if p != len(timeline.Pauses)-1 {
+				return fmt.Errorf("%w: pause #%d is not resumed", ErrInvalidTimeline, p)
+			}
+
+			break
+		}
+
+		if pause.ResumedAt.Before(pause.PausedAt) {
+			return fmt.Errorf("%w: pause #%d is resumed before paused", ErrInvalidTimeline, p)
+		}
+
+		lastEventAt = pause.ResumedAt
+	}
+
+	return nil
+}
+
+// CalculateTimelineDueDate returns the due date of the turnaround, not counting the paused periods.
+// ErrTimelinePaused is returned, if the timeline is paused, because the due date depends on the resume.
+func (calendar *Calendar) CalculateTimelineDueDate(
+	timeline Timeline, turnaroundDurationHour float64,
+) (time.Time, error) {
+	if err := ValidateTurnaroundHours(turnaroundDurationHour); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := calendar.validateTimeline(timeline); err != nil {
+		return time.Time{}, err
+	}
+
+	remaining := HourToDuration(turnaroundDurationHour)
+	runningAt := timeline.SubmitAt
+
+	for _, pause := range timeline.Pauses {
+		working := calendar.WorkingDurationBetween(runningAt, pause.PausedAt)
+		if working >= remaining {
+			break
+		}
+
+		if pause.ResumedAt.IsZero() {
+			return time.Time{}, fmt.Errorf("%w: since %s", ErrTimelinePaused, calendar.formatTime(pause.PausedAt))
+		}
+
+		remaining -= working
+		runningAt = pause.ResumedAt
+	}
+
+	return calendar.addWorkingDuration(runningAt, remaining)
+}
+
+// TimelineWorkingDuration returns the working time of the timeline till at, not counting the paused periods.
+func (calendar *Calendar) TimelineWorkingDuration(timeline Timeline, at time.Time) (time.Duration, error) {
+	if err := calendar.validateTimeline(timeline); err != nil {
+		return 0, err
+	}
+
+	working := time.Duration(0)
+	runningAt := timeline.SubmitAt
+
+	for _, pause := range timeline.Pauses {
+		if !pause.PausedAt.Before(at) {
+			break
+		}
+
+		working += calendar.WorkingDurationBetween(runningAt, pause.PausedAt)
+
+		if pause.ResumedAt.IsZero() || !pause.ResumedAt.Before(at) {
+			return working, nil
+		}
+
+		runningAt = pause.ResumedAt
+	}
+
+	if runningAt.Before(at) {
+		working += calendar.WorkingDurationBetween(runningAt, at)
+	}
+
+	return working, nil
+}
+
+// RemainingWorkingDuration returns the working time remained from the turnaround at the given time.
+// It's negative, if the due date is exceeded.
+func (calendar *Calendar) RemainingWorkingDuration(
+	timeline Timeline, turnaroundDurationHour float64, at time.Time,
+) (time.Duration, error) {
+	if err := ValidateTurnaroundHours(turnaroundDurationHour); err != nil {
+		return 0, err
+	}
+
+	working, err := calendar.TimelineWorkingDuration(timeline, at)
+	if err != nil {
+		return 0, err
+	}
+
+	return HourToDuration(turnaroundDurationHour) - working, nil
+}
+
+func (calendar *Calendar) validateTimeline(timeline Timeline) error {
+	if err := calendar.validateSubmitTime(timeline.SubmitAt); err != nil {
+		return err
+	}
+
+	return timeline.validate()
+}
+
+// addWorkingDuration returns the time, having duration working time from at. The at may be off the working hours.
+func (calendar *Calendar) addWorkingDuration(at time.Time, duration time.Duration) (time.Time, error) {
+	workAt, err := calendar.index.nextWorkTime(at)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	workTime := AdjustableWorkTime{
+		config: calendar.config,
+		time:   workAt,
+		adjust: duration,
+		index:  calendar.index,
+	}
+
+	return workTime.appendWeeks().appendWorkdayHours().appendToday().dueAt()
+}
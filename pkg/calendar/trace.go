@@ -0,0 +1,157 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// TraceKind is the kind of a step of a due-date calculation.
+type TraceKind string
+
+const (
+	// TraceWeeks consumes whole weeks.
+	TraceWeeks TraceKind = "weeks"
+	// TraceWorkday consumes a whole working day, from the same time of the previous working day.
+	TraceWorkday TraceKind = "workday"
+	// TraceSegment consumes the working hours of a day, or a part of them.
+	TraceSegment TraceKind = "segment"
+	// TraceSkip is a non-working day.
+	TraceSkip TraceKind = "skip"
+)
+
+// TraceStep is a step of a due-date calculation. Remaining is the turnaround remained after the step.
+// Note is the number of the weeks or the reason of a non-working day.
+type TraceStep struct {
+	Kind      TraceKind     `json:"kind"`
+	Date      Date          `json:"date"`
+	Start     time.Time     `json:"start"`
+	End       time.Time     `json:"end"`
+	Consumed  time.Duration `json:"consumed"`
+	Remaining time.Duration `json:"remaining"`
+	Note      string        `json:"note,omitempty"`
+}
+
+// Trace explains the calculation of a due date.
+type Trace struct {
+	SubmitAt   time.Time     `json:"submitAt"`
+	Turnaround time.Duration `json:"turnaround"`
+	DueAt      time.Time     `json:"dueAt"`
+	Steps      []TraceStep   `json:"steps"`
+}
+
+// CalculateDueDateTrace calculates the due date like CalculateDueDate, and returns the steps of the calculation, too.
+func (calendar *Calendar) CalculateDueDateTrace(submitAt time.Time, turnaroundDurationHour float64) (Trace, error) {
+	return calendar.CalculateDueDateTraceLocale(submitAt, turnaroundDurationHour, LocaleEnglish)
+}
+
+// CalculateDueDateTraceLocale is CalculateDueDateTrace, having the notes of the steps in the language of the locale.
+func (calendar *Calendar) CalculateDueDateTraceLocale(
+	submitAt time.Time, turnaroundDurationHour float64, locale Locale,
+) (Trace, error) {
+	if err := ValidateTurnaroundHours(turnaroundDurationHour); err != nil {
+		return Trace{}, err
+	}
+
+	if err := calendar.validateSubmitTime(submitAt); err != nil {
+		return Trace{}, err
+	}
+
+	trace := Trace{
+		SubmitAt:   submitAt,
+		Turnaround: HourToDuration(turnaroundDurationHour),
+		DueAt:      time.Time{},
+		Steps:      []TraceStep{},
+	}
+
+	dueCalculator := AdjustableWorkTime{
+		config: calendar.config,
+		time:   submitAt,
+		adjust: trace.Turnaround,
+		index:  calendar.index,
+		trace:  &trace,
+		locale: locale,
+	}
+
+	dueAt, err := dueCalculator.appendWeeks().appendWorkdayHours().appendToday().dueAt()
+	if err != nil {
+		return Trace{}, err
+	}
+
+	trace.DueAt = dueAt
+
+	return trace, nil
+}
+
+// appendTrace consumes the working time till the given time, and records it, if tracing.
+// The non-working days of a multi-day step are recorded after it.
+func (workTime *AdjustableWorkTime) appendTrace(kind TraceKind, to time.Time, consumed time.Duration, note string) {
+	from := workTime.time
+	workTime.time = to
+	workTime.adjust -= consumed
+
+	if workTime.trace == nil {
+		return
+	}
+
+	workTime.trace.Steps = append(workTime.trace.Steps, TraceStep{
+		Kind:      kind,
+		Date:      DateOf(from),
+		Start:     from,
+		End:       to,
+		Consumed:  consumed,
+		Remaining: workTime.adjust,
+		Note:      note,
+	})
+
+	if kind == TraceWeeks || kind == TraceWorkday {
+		workTime.traceSkippedDays(DateOf(from).AddDays(1), DateOf(to))
+	}
+}
+
+// traceSkippedDays records the non-working days from (inclusive) to (exclusive), if tracing.
+func (workTime *AdjustableWorkTime) traceSkippedDays(from, to Date) {
+	if workTime.trace == nil {
+		return
+	}
+
+	for date := from; date.Before(to); date = date.AddDays(1) {
+		if _, _, working := workTime.workIndex().workHours(date); working {
+			continue
+		}
+
+		workTime.trace.Steps = append(workTime.trace.Steps, TraceStep{
+			Kind:      TraceSkip,
+			Date:      date,
+			Start:     date.In(workTime.time.Location()),
+			End:       date.AddDays(1).In(workTime.time.Location()),
+			Consumed:  0,
+			Remaining: workTime.adjust,
+			Note:      workTime.workIndex().nonWorkingReason(date, workTime.locale),
+		})
+	}
+}
+
+// nonWorkingReason returns why the date is a non-working day, in the language of the locale.
+func (index *workIndex) nonWorkingReason(date Date, locale Locale) string {
+	if _, is := index.config.override(date); is {
+		return locale.catalogue().override
+	}
+
+	if !index.config.isWeeklyWorkday(date.Weekday()) {
+		return locale.Weekday(date.Weekday())
+	}
+
+	if holiday, is := index.holiday(date); is {
+		return holiday.Name
+	}
+
+	return ""
+}
+
+func (locale Locale) weeksNote(weeks int) string {
+	if weeks == 1 {
+		return locale.catalogue().week
+	}
+
+	return fmt.Sprintf(locale.catalogue().weeks, weeks)
+}
@@ -0,0 +1,147 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var ErrInvalidWorkingDuration = errors.New("invalid working duration")
+
+// workingDurationUnit is a unit of FormatWorkingDuration. Zero unit is the working day.
+type workingDurationUnit struct {
+	names []string
+	unit  time.Duration
+}
+
+//nolint:gochecknoglobals // read-only units of FormatWorkingDuration, in order
+var workingDurationUnits = []workingDurationUnit{
+	{names: []string{"working day", "working days"}, unit: 0},
+	{names: []string{"h"}, unit: time.Hour},
+	{names: []string{"m"}, unit: time.Minute},
+	{names: []string{"s"}, unit: time.Second},
+}
+
+/*
+FormatWorkingDuration formats the working time in working days and hours, minutes, seconds,
+for example "2 working days 3h 30m". A working day is the daily working time of the calendar,
+which is the same on all working days of the week. Zero is "0h".
+*/
+func (calendar *Calendar) FormatWorkingDuration(duration time.Duration) string {
+	return calendar.FormatWorkingDurationLocale(duration, LocaleEnglish)
+}
+
+// FormatWorkingDurationLocale is FormatWorkingDuration, having the working days in the language of the locale.
+// Only the English format can be parsed by ParseWorkingDuration.
+func (calendar *Calendar) FormatWorkingDurationLocale(duration time.Duration, locale Locale) string {
+	messages := locale.catalogue()
+	parts := []string{}
+	sign := ""
+
+	if duration < 0 {
+		sign, duration = "-", -duration
+	}
+
+	if days := duration / calendar.DailyWorkDuration(); days == 1 {
+		parts = append(parts, "1 "+messages.workingDay)
+		duration -= calendar.DailyWorkDuration()
+	} else if days > 1 {
+		parts = append(parts, fmt.Sprintf("%d %s", days, messages.workingDays))
+		duration -= days * calendar.DailyWorkDuration()
+	}
+
+	for _, unit := range []time.Duration{time.Hour, time.Minute} {
+		if count := duration / unit; count > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", count, workingDurationUnitName(unit)))
+			duration -= count * unit
+		}
+	}
+
+	if duration > 0 {
+		parts = append(parts, strconv.FormatFloat(duration.Seconds(), 'f', -1, 64)+"s")
+	}
+
+	if len(parts) == 0 {
+		return "0h"
+	}
+
+	return sign + strings.Join(parts, " ")
+}
+
+func workingDurationUnitName(unit time.Duration) string {
+	for _, named := range workingDurationUnits {
+		if named.unit == unit {
+			return named.names[0]
+		}
+	}
+
+	return ""
+}
+
+// ParseWorkingDuration parses the format of FormatWorkingDuration. The units are optional, but must be in order.
+func (calendar *Calendar) ParseWorkingDuration(value string) (time.Duration, error) {
+	text := strings.TrimSpace(value)
+	sign := time.Duration(1)
+
+	if strings.HasPrefix(text, "-") {
+		text, sign = text[1:], -1
+	}
+
+	units := workingDurationUnits
+	duration := time.Duration(0)
+
+	for text = strings.TrimSpace(text); text != ""; text = strings.TrimSpace(text) {
+		end := strings.IndexFunc(text, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if end <= 0 {
+			return 0, fmt.Errorf("%w: %q, missing amount", ErrInvalidWorkingDuration, value)
+		}
+
+		amount, err := strconv.ParseFloat(text[:end], 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q, invalid amount", ErrInvalidWorkingDuration, value)
+		}
+
+		text = strings.TrimSpace(text[end:])
+		u, name := matchWorkingDurationUnit(units, text)
+
+		if u < 0 {
+			return 0, fmt.Errorf("%w: %q, unexpected %q", ErrInvalidWorkingDuration, value, text)
+		}
+
+		unit := units[u].unit
+		if unit == 0 {
+			unit = calendar.DailyWorkDuration()
+		}
+
+		var fits bool
+		if duration, fits = addUnits(duration, amount, unit); !fits {
+			return 0, fmt.Errorf("%w: %q, too long", ErrInvalidWorkingDuration, value)
+		}
+
+		text = text[len(name):]
+		units = units[u+1:]
+	}
+
+	if len(units) == len(workingDurationUnits) {
+		return 0, fmt.Errorf("%w: %q, missing value", ErrInvalidWorkingDuration, value)
+	}
+
+	return sign * duration, nil
+}
+
+// matchWorkingDurationUnit returns the index and the name of the unit at the beginning of text, or -1.
+func matchWorkingDurationUnit(units []workingDurationUnit, text string) (int, string) {
+	for u, unit := range units {
+		for n := len(unit.names) - 1; n >= 0; n-- {
+			name := unit.names[n]
+			if strings.HasPrefix(text, name) && (len(text) == len(name) || !unicode.IsLetter(rune(text[len(name)]))) {
+				return u, name
+			}
+		}
+	}
+
+	return -1, ""
+}
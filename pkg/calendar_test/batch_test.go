@@ -0,0 +1,133 @@
+package calendar_test
+
+import (
+	"math"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/hu"
+)
+
+func (s *CalendarTestSuite) TestCalculateDueDates() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Assert().NoError(err)
+
+	requests := []calendar.DueDateRequest{
+		{SubmitAt: parseTimeRfc3339("2021-10-13T09:30:00+04:00"), TurnaroundDurationHour: 5.5},
+		{SubmitAt: parseTimeRfc3339("2021-10-16T08:30:00+04:00"), TurnaroundDurationHour: 9.5},
+		{SubmitAt: parseTimeRfc3339("2021-10-13T16:20:00+04:00"), TurnaroundDurationHour: 2},
+		{SubmitAt: parseTimeRfc3339("2021-10-13T09:20:00+04:00"), TurnaroundDurationHour: 104.5},
+		{SubmitAt: parseTimeRfc3339("2021-10-11T09:20:00+04:00"), TurnaroundDurationHour: 79},
+		{SubmitAt: parseTimeRfc3339("2021-10-13T09:30:00+04:00"), TurnaroundDurationHour: -3},
+		{SubmitAt: parseTimeRfc3339("2021-10-13T09:30:00+04:00"), TurnaroundDurationHour: math.NaN()},
+		{SubmitAt: parseTimeRfc3339("2021-10-13T09:30:00+04:00"), TurnaroundDurationHour: 1e300},
+	}
+
+	expectedResults := []struct {
+		resolvedAt time.Time
+		err        error
+	}{
+		{resolvedAt: parseTimeRfc3339("2021-10-13T15:00:00+04:00"), err: nil},
+		{resolvedAt: time.Time{}, err: calendar.ErrInvalidSubmitTime},
+		{resolvedAt: parseTimeRfc3339("2021-10-14T10:20:00+04:00"), err: nil},
+		{resolvedAt: parseTimeRfc3339("2021-11-01T09:50:00+04:00"), err: nil},
+		{resolvedAt: parseTimeRfc3339("2021-10-22T16:20:00+04:00"), err: nil},
+		{resolvedAt: time.Time{}, err: calendar.ErrInvalidTurnaround},
+		{resolvedAt: time.Time{}, err: calendar.ErrInvalidTurnaround},
+		{resolvedAt: time.Time{}, err: calendar.ErrInvalidTurnaround},
+	}
+
+	for _, workers := range []int{0, 1, 2, 3, 10} {
+		results := calendarTest.CalculateDueDates(requests, workers)
+
+		s.Assert().Len(results, len(expectedResults), "workers: %d", workers)
+
+		for r, result := range results {
+			s.Assert().ErrorIs(result.Err, expectedResults[r].err, "workers: %d, #%d", workers, r)
+			s.Assert().Equal(
+				expectedResults[r].resolvedAt.Format(calendar.TimeFormatDefault),
+				result.ResolvedAt.Format(calendar.TimeFormatDefault),
+				"workers: %d, #%d", workers, r,
+			)
+		}
+	}
+
+	s.Assert().Empty(calendarTest.CalculateDueDates(nil, 4))
+}
+
+func benchmarkCalendar(b *testing.B) *calendar.Calendar {
+	b.Helper()
+
+	calendarBenchmark, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return calendarBenchmark
+}
+
+func benchmarkRequests(count int) []calendar.DueDateRequest {
+	requests := make([]calendar.DueDateRequest, count)
+	submitAt := parseTimeRfc3339("2021-10-11T09:00:00+02:00")
+
+	for r := range requests {
+		requests[r] = calendar.DueDateRequest{
+			SubmitAt:               submitAt.Add(time.Duration(r%8) * time.Hour),
+			TurnaroundDurationHour: float64(r%100) + 0.5,
+		}
+	}
+
+	return requests
+}
+
+const benchmarkBatchSize = 1000
+
+func BenchmarkCalculateDueDate(b *testing.B) {
+	calendarBenchmark := benchmarkCalendar(b)
+	requests := benchmarkRequests(benchmarkBatchSize)
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		for _, request := range requests {
+			_, _ = calendarBenchmark.CalculateDueDate(request.SubmitAt, request.TurnaroundDurationHour)
+		}
+	}
+}
+
+func BenchmarkCalculateDueDatesSequential(b *testing.B) {
+	calendarBenchmark := benchmarkCalendar(b)
+	requests := benchmarkRequests(benchmarkBatchSize)
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		calendarBenchmark.CalculateDueDates(requests, 1)
+	}
+}
+
+func BenchmarkCalculateDueDatesParallel(b *testing.B) {
+	calendarBenchmark := benchmarkCalendar(b)
+	requests := benchmarkRequests(benchmarkBatchSize)
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		calendarBenchmark.CalculateDueDates(requests, runtime.NumCPU())
+	}
+}
@@ -1,6 +1,7 @@
 package calendar_test
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -32,6 +33,9 @@ func (s *CalendarTestSuite) TestCalculateDueDate() {
 	})
 	s.Assert().NoError(err)
 
+	budapest, err := time.LoadLocation("Europe/Budapest")
+	s.Require().NoError(err)
+
 	testCases := []struct {
 		name string
 
@@ -62,6 +66,34 @@ func (s *CalendarTestSuite) TestCalculateDueDate() {
 			expectedResolvedAt:     time.Time{},
 			expectedErr:            calendar.ErrInvalidSubmitTime,
 		},
+		{
+			name:                   "Negative turnaround",
+			submitAt:               parseTimeRfc3339("2021-10-13T09:30:00+04:00"),
+			turnaroundDurationHour: -3,
+			expectedResolvedAt:     time.Time{},
+			expectedErr:            calendar.ErrInvalidTurnaround,
+		},
+		{
+			name:                   "Not a number turnaround",
+			submitAt:               parseTimeRfc3339("2021-10-13T09:30:00+04:00"),
+			turnaroundDurationHour: math.NaN(),
+			expectedResolvedAt:     time.Time{},
+			expectedErr:            calendar.ErrInvalidTurnaround,
+		},
+		{
+			name:                   "Infinite turnaround",
+			submitAt:               parseTimeRfc3339("2021-10-13T09:30:00+04:00"),
+			turnaroundDurationHour: math.Inf(1),
+			expectedResolvedAt:     time.Time{},
+			expectedErr:            calendar.ErrInvalidTurnaround,
+		},
+		{
+			name:                   "Too long turnaround",
+			submitAt:               parseTimeRfc3339("2021-10-13T09:30:00+04:00"),
+			turnaroundDurationHour: 1e300,
+			expectedResolvedAt:     time.Time{},
+			expectedErr:            calendar.ErrInvalidTurnaround,
+		},
 		{
 			name:                   "Same day resolved",
 			submitAt:               parseTimeRfc3339("2021-10-13T09:30:00+04:00"),
@@ -132,6 +164,34 @@ func (s *CalendarTestSuite) TestCalculateDueDate() {
 			expectedResolvedAt:     parseTimeRfc3339("2021-10-22T16:20:00+04:00"),
 			expectedErr:            nil,
 		},
+		{
+			name:                   "One minute in hours",
+			submitAt:               parseTimeRfc3339("2021-10-13T09:20:00+04:00"),
+			turnaroundDurationHour: 1.0 / 60,
+			expectedResolvedAt:     parseTimeRfc3339("2021-10-13T09:21:00+04:00"),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "65 minutes in hours",
+			submitAt:               parseTimeRfc3339("2021-10-13T09:20:00+04:00"),
+			turnaroundDurationHour: 65.0 / 60,
+			expectedResolvedAt:     parseTimeRfc3339("2021-10-13T10:25:00+04:00"),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Week over the end of daylight saving time",
+			submitAt:               time.Date(2021, time.October, 27, 9, 20, 0, 0, budapest),
+			turnaroundDurationHour: 40,
+			expectedResolvedAt:     time.Date(2021, time.November, 3, 9, 20, 0, 0, budapest),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Days over the end of daylight saving time",
+			submitAt:               time.Date(2021, time.October, 29, 9, 20, 0, 0, budapest),
+			turnaroundDurationHour: 16,
+			expectedResolvedAt:     time.Date(2021, time.November, 2, 9, 20, 0, 0, budapest),
+			expectedErr:            nil,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -148,3 +208,166 @@ func (s *CalendarTestSuite) TestCalculateDueDate() {
 		})
 	}
 }
+
+func (s *CalendarTestSuite) TestValidateTurnaroundHours() {
+	testCases := []struct {
+		name string
+
+		hours float64
+
+		expectedErr error
+	}{
+		{name: "Zero", hours: 0, expectedErr: nil},
+		{name: "Fraction", hours: 2.5, expectedErr: nil},
+		{name: "Negative", hours: -1, expectedErr: calendar.ErrInvalidTurnaround},
+		{name: "Too long", hours: 1e300, expectedErr: calendar.ErrInvalidTurnaround},
+		{name: "Infinite", hours: math.Inf(1), expectedErr: calendar.ErrInvalidTurnaround},
+		{name: "Negative infinite", hours: math.Inf(-1), expectedErr: calendar.ErrInvalidTurnaround},
+		{name: "Not a number", hours: math.NaN(), expectedErr: calendar.ErrInvalidTurnaround},
+		{name: "Longest", hours: math.Floor(math.MaxInt64 / float64(time.Hour)), expectedErr: nil},
+		{
+			name:        "Rounded over the longest",
+			hours:       math.MaxInt64 / float64(time.Hour),
+			expectedErr: calendar.ErrInvalidTurnaround,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			s.Assert().ErrorIs(calendar.ValidateTurnaroundHours(testCase.hours), testCase.expectedErr)
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestWorkingDurationBetween() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Assert().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		from time.Time
+		to   time.Time
+
+		expectedDuration time.Duration
+	}{
+		{
+			name:             "Same day",
+			from:             parseTimeRfc3339("2021-10-13T09:30:00+04:00"),
+			to:               parseTimeRfc3339("2021-10-13T15:00:00+04:00"),
+			expectedDuration: calendar.HourToDuration(5.5),
+		},
+		{
+			name:             "Off hours",
+			from:             parseTimeRfc3339("2021-10-13T06:00:00+04:00"),
+			to:               parseTimeRfc3339("2021-10-14T11:00:00+04:00"),
+			expectedDuration: calendar.HourToDuration(10),
+		},
+		{
+			name:             "Over weekend",
+			from:             parseTimeRfc3339("2021-10-15T16:00:00+04:00"),
+			to:               parseTimeRfc3339("2021-10-18T10:00:00+04:00"),
+			expectedDuration: calendar.HourToDuration(2),
+		},
+		{
+			name:             "Different locations",
+			from:             parseTimeRfc3339("2021-10-15T16:00:00+04:00"),
+			to:               parseTimeRfc3339("2021-10-18T06:00:00Z"),
+			expectedDuration: calendar.HourToDuration(2),
+		},
+		{
+			name:             "Reversed",
+			from:             parseTimeRfc3339("2021-10-18T10:00:00+04:00"),
+			to:               parseTimeRfc3339("2021-10-15T16:00:00+04:00"),
+			expectedDuration: calendar.HourToDuration(-2),
+		},
+		{
+			name:             "Weeks",
+			from:             parseTimeRfc3339("2021-10-13T09:20:00+04:00"),
+			to:               parseTimeRfc3339("2021-11-01T09:50:00+04:00"),
+			expectedDuration: calendar.HourToDuration(104.5),
+		},
+		{
+			name:             "Over 292 years",
+			from:             parseTimeRfc3339("2000-01-01T00:00:00Z"),
+			to:               parseTimeRfc3339("2400-01-01T00:00:00Z"),
+			expectedDuration: calendar.HourToDuration(146097 / 7 * 40),
+		},
+		{
+			name:             "Longer than MaxWorkingDuration",
+			from:             parseTimeRfc3339("0001-01-01T00:00:00Z"),
+			to:               parseTimeRfc3339("9999-12-31T00:00:00Z"),
+			expectedDuration: calendar.MaxWorkingDuration,
+		},
+		{
+			name:             "Reversed longer than MaxWorkingDuration",
+			from:             parseTimeRfc3339("2500-01-01T00:00:00Z"),
+			to:               parseTimeRfc3339("0001-01-01T00:00:00Z"),
+			expectedDuration: -calendar.MaxWorkingDuration,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			s.Assert().Equal(
+				testCase.expectedDuration,
+				calendarTest.WorkingDurationBetween(testCase.from, testCase.to),
+			)
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestIsWorkingTime() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Assert().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		at time.Time
+
+		expectedWorkingTime bool
+	}{
+		{
+			name:                "Working hours",
+			at:                  parseTimeRfc3339("2021-10-13T09:30:00+04:00"),
+			expectedWorkingTime: true,
+		},
+		{
+			name:                "Beginning of working hours",
+			at:                  parseTimeRfc3339("2021-10-13T09:00:00+04:00"),
+			expectedWorkingTime: true,
+		},
+		{
+			name:                "End of working hours",
+			at:                  parseTimeRfc3339("2021-10-13T17:00:00+04:00"),
+			expectedWorkingTime: false,
+		},
+		{
+			name:                "Weekend",
+			at:                  parseTimeRfc3339("2021-10-16T10:00:00+04:00"),
+			expectedWorkingTime: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			s.Assert().Equal(testCase.expectedWorkingTime, calendarTest.IsWorkingTime(testCase.at))
+		})
+	}
+}
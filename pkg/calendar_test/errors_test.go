@@ -0,0 +1,97 @@
+package calendar_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/hu"
+)
+
+//nolint:exhaustivestruct // only the fields of the reason are set
+func (s *CalendarTestSuite) TestSubmitTimeError() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+		Overrides: []calendar.DayOverride{
+			{Date: calendar.NewDate(2021, time.December, 24), Working: false},
+		},
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		submitAt time.Time
+
+		expectedErr     calendar.SubmitTimeError
+		expectedMessage string
+	}{
+		{
+			name:     "Day off",
+			submitAt: parseTimeRfc3339("2021-12-24T10:00:00+01:00"),
+			expectedErr: calendar.SubmitTimeError{
+				SubmitAt: parseTimeRfc3339("2021-12-24T10:00:00+01:00"),
+				Reason:   calendar.ReasonDayOff,
+			},
+			expectedMessage: "invalid submit datetime: 2021-12-24T10:00:00+01:00, 2021-12-24 is not a workday",
+		},
+		{
+			name:     "Weekend",
+			submitAt: parseTimeRfc3339("2021-12-18T10:00:00+01:00"),
+			expectedErr: calendar.SubmitTimeError{
+				SubmitAt:     parseTimeRfc3339("2021-12-18T10:00:00+01:00"),
+				Reason:       calendar.ReasonWeekday,
+				FirstWorkday: time.Monday,
+				LastWorkday:  time.Friday,
+			},
+			expectedMessage: "invalid submit datetime: 2021-12-18T10:00:00+01:00, must be Monday - Friday",
+		},
+		{
+			name:     "Holiday",
+			submitAt: parseTimeRfc3339("2021-11-01T10:00:00+01:00"),
+			expectedErr: calendar.SubmitTimeError{
+				SubmitAt: parseTimeRfc3339("2021-11-01T10:00:00+01:00"),
+				Reason:   calendar.ReasonHoliday,
+				Holiday:  "Mindenszentek",
+			},
+			expectedMessage: "invalid submit datetime: 2021-11-01T10:00:00+01:00, Mindenszentek is a holiday",
+		},
+		{
+			name:     "Out of working hours",
+			submitAt: parseTimeRfc3339("2021-11-02T08:00:00+01:00"),
+			expectedErr: calendar.SubmitTimeError{
+				SubmitAt:    parseTimeRfc3339("2021-11-02T08:00:00+01:00"),
+				Reason:      calendar.ReasonWorkHours,
+				WindowStart: parseTimeRfc3339("2021-11-02T09:00:00+01:00"),
+				WindowEnd:   parseTimeRfc3339("2021-11-02T17:00:00+01:00"),
+			},
+			expectedMessage: "invalid submit datetime: 2021-11-02T08:00:00+01:00, " +
+				"must be 2021-11-02T09:00:00+01:00 - 2021-11-02T17:00:00+01:00",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			_, err := calendarTest.CalculateDueDate(testCase.submitAt, 1)
+
+			s.Assert().ErrorIs(err, calendar.ErrInvalidSubmitTime)
+			s.Assert().EqualError(err, testCase.expectedMessage)
+
+			var submitTimeError *calendar.SubmitTimeError
+			s.Require().True(errors.As(err, &submitTimeError))
+			s.Assert().Equal(testCase.expectedErr.SubmitAt, submitTimeError.SubmitAt)
+			s.Assert().Equal(testCase.expectedErr.Reason, submitTimeError.Reason)
+			s.Assert().Equal(testCase.expectedErr.WindowStart, submitTimeError.WindowStart)
+			s.Assert().Equal(testCase.expectedErr.WindowEnd, submitTimeError.WindowEnd)
+			s.Assert().Equal(testCase.expectedErr.FirstWorkday, submitTimeError.FirstWorkday)
+			s.Assert().Equal(testCase.expectedErr.LastWorkday, submitTimeError.LastWorkday)
+			s.Assert().Equal(testCase.expectedErr.Holiday, submitTimeError.Holiday)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+//go:build go1.18
+// +build go1.18
+
+package calendar_test
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// FuzzDueDateProperties checks the properties of the due date on the random case of the seed,
+// with the fuzzed turnaround in minutes.
+func FuzzDueDateProperties(f *testing.F) {
+	f.Add(int64(1), uint16(0))
+	f.Add(int64(2), uint16(8*60))
+	f.Add(int64(3), uint16(propertyMaxMinutes))
+
+	f.Fuzz(func(t *testing.T, seed int64, minutes uint16) {
+		testCase, err := newPropertyCase(rand.New(rand.NewSource(seed))) //nolint:gosec // deterministic test data
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testCase.minutes = int(minutes) % (propertyMaxMinutes + 1)
+
+		if err := checkDueDateProperties(testCase); err != nil {
+			t.Fatalf("%s: %s", testCase, err)
+		}
+	})
+}
@@ -0,0 +1,147 @@
+package calendar_test
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+func holderTestConfig(workBegins time.Duration) calendar.Config {
+	return calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     workBegins,
+		WorkEnds:       workBegins + 8*time.Hour,
+		TimeFormat:     calendar.TimeFormatDefault,
+	}
+}
+
+func (s *CalendarTestSuite) TestHolder() {
+	calendarNine, err := calendar.NewCalendar(holderTestConfig(9 * time.Hour))
+	s.Require().NoError(err)
+
+	holder, err := calendar.NewHolder(calendarNine)
+	s.Require().NoError(err)
+	calculateDueDateNine := calendarNine.CalculateDueDateFunc()
+	calculateDueDateCurrent := holder.CalculateDueDateFunc()
+	submitAt := parseTimeRfc3339("2021-10-13T10:00:00+02:00")
+
+	s.Assert().Equal(uint64(1), holder.Version())
+	s.Assert().Same(calendarNine, holder.Calendar())
+
+	resolvedAt, err := calculateDueDateCurrent(submitAt, 6.5)
+	s.Require().NoError(err)
+	s.Assert().Equal(parseTimeRfc3339("2021-10-13T16:30:00+02:00"), resolvedAt)
+
+	version, err := holder.Reconfigure(holderTestConfig(8 * time.Hour))
+	s.Require().NoError(err)
+	s.Assert().Equal(uint64(2), version)
+
+	resolvedAt, err = calculateDueDateCurrent(submitAt, 6.5)
+	s.Require().NoError(err)
+	s.Assert().Equal(parseTimeRfc3339("2021-10-14T08:30:00+02:00"), resolvedAt)
+
+	resolvedAt, err = calculateDueDateNine(submitAt, 6.5)
+	s.Require().NoError(err)
+	s.Assert().Equal(parseTimeRfc3339("2021-10-13T16:30:00+02:00"), resolvedAt)
+
+	version, err = holder.Reconfigure(holderTestConfig(20 * time.Hour))
+	s.Assert().ErrorIs(err, calendar.ErrInvalidWorkTime)
+	s.Assert().Equal(uint64(2), version)
+	s.Assert().Equal(uint64(2), holder.Version())
+
+	version, err = holder.Swap(calendarNine)
+	s.Require().NoError(err)
+	s.Assert().Equal(uint64(3), version)
+	s.Assert().Same(calendarNine, holder.Calendar())
+
+	version, err = holder.Swap(nil)
+	s.Assert().ErrorIs(err, calendar.ErrNilCalendar)
+	s.Assert().Equal(uint64(3), version)
+	s.Assert().Same(calendarNine, holder.Calendar())
+}
+
+func (s *CalendarTestSuite) TestNilHolder() {
+	holder, err := calendar.NewHolder(nil)
+	s.Assert().ErrorIs(err, calendar.ErrNilCalendar)
+	s.Assert().Nil(holder)
+}
+
+// TestHolderConcurrent is meaningful with the race detector: go test -race.
+func (s *CalendarTestSuite) TestHolderConcurrent() {
+	calendarNine, err := calendar.NewCalendar(holderTestConfig(9 * time.Hour))
+	s.Require().NoError(err)
+
+	calendarEight, err := calendar.NewCalendar(holderTestConfig(8 * time.Hour))
+	s.Require().NoError(err)
+
+	holder, err := calendar.NewHolder(calendarNine)
+	s.Require().NoError(err)
+	calculateDueDateNine := calendarNine.CalculateDueDateFunc()
+	calculateDueDateCurrent := holder.CalculateDueDateFunc()
+	submitAt := parseTimeRfc3339("2021-10-13T10:00:00+02:00")
+	expectedNine := parseTimeRfc3339("2021-10-13T16:30:00+02:00")
+	expectedEight := parseTimeRfc3339("2021-10-14T08:30:00+02:00")
+
+	const (
+		readers = 8
+		calls   = 200
+		swaps   = 100
+	)
+
+	failures := make(chan string, readers*calls+swaps)
+	waitGroup := sync.WaitGroup{}
+	waitGroup.Add(readers + 1)
+
+	go func() {
+		defer waitGroup.Done()
+
+		for n := 0; n < swaps; n++ {
+			next := calendarNine
+			if n%2 == 0 {
+				next = calendarEight
+			}
+
+			if _, err := holder.Swap(next); err != nil {
+				failures <- "unexpected swap error: " + err.Error()
+			}
+		}
+	}()
+
+	for r := 0; r < readers; r++ {
+		go func() {
+			defer waitGroup.Done()
+
+			lastVersion := uint64(0)
+
+			for n := 0; n < calls; n++ {
+				if version := holder.Version(); version < lastVersion {
+					failures <- "version decreased"
+				} else {
+					lastVersion = version
+				}
+
+				resolvedAt, err := calculateDueDateCurrent(submitAt, 6.5)
+				if err != nil || !(resolvedAt.Equal(expectedNine) || resolvedAt.Equal(expectedEight)) {
+					failures <- "unexpected current result: " + resolvedAt.String()
+				}
+
+				resolvedAt, err = calculateDueDateNine(submitAt, 6.5)
+				if err != nil || !resolvedAt.Equal(expectedNine) {
+					failures <- "unexpected bound result: " + resolvedAt.String()
+				}
+			}
+		}()
+	}
+
+	waitGroup.Wait()
+	close(failures)
+
+	for failure := range failures {
+		s.Fail(failure)
+	}
+
+	s.Assert().Equal(uint64(1+swaps), holder.Version())
+	s.Assert().Same(calendarNine, holder.Calendar())
+}
@@ -0,0 +1,289 @@
+package calendar_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/hu"
+)
+
+func (s *CalendarTestSuite) TestHolidayRules() {
+	testCases := []struct {
+		name string
+
+		rule calendar.HolidayRule
+		year int
+
+		expectedDates []calendar.Date
+	}{
+		{
+			name:          "Easter 2021",
+			rule:          calendar.EasterHoliday{Name: "Easter", Offset: 0},
+			year:          2021,
+			expectedDates: []calendar.Date{{Year: 2021, Month: time.April, Day: 4}},
+		},
+		{
+			name:          "Easter 2000",
+			rule:          calendar.EasterHoliday{Name: "Easter", Offset: 0},
+			year:          2000,
+			expectedDates: []calendar.Date{{Year: 2000, Month: time.April, Day: 23}},
+		},
+		{
+			name:          "Whit Monday 2038",
+			rule:          calendar.EasterHoliday{Name: "Whit Monday", Offset: 50},
+			year:          2038,
+			expectedDates: []calendar.Date{{Year: 2038, Month: time.June, Day: 14}},
+		},
+		{
+			name:          "First Monday",
+			rule:          calendar.WeekdayHoliday{Name: "First", Month: time.November, Weekday: time.Monday, Nth: 1},
+			year:          2021,
+			expectedDates: []calendar.Date{{Year: 2021, Month: time.November, Day: 1}},
+		},
+		{
+			name:          "Last Monday",
+			rule:          calendar.WeekdayHoliday{Name: "Last", Month: time.May, Weekday: time.Monday, Nth: -1},
+			year:          2021,
+			expectedDates: []calendar.Date{{Year: 2021, Month: time.May, Day: 31}},
+		},
+		{
+			name:          "No 5th Friday",
+			rule:          calendar.WeekdayHoliday{Name: "Fifth", Month: time.February, Weekday: time.Friday, Nth: 5},
+			year:          2021,
+			expectedDates: []calendar.Date{},
+		},
+		{
+			name: "Observed on Friday",
+			rule: calendar.ObservedHoliday{
+				Rule: calendar.FixedHoliday{Name: "Christmas", Month: time.December, Day: 25},
+			},
+			year:          2021,
+			expectedDates: []calendar.Date{{Year: 2021, Month: time.December, Day: 24}},
+		},
+		{
+			name: "Before years",
+			rule: calendar.HolidayYears{
+				Rule: calendar.FixedHoliday{Name: "Christmas", Month: time.December, Day: 25},
+				From: 2022,
+			},
+			year:          2021,
+			expectedDates: []calendar.Date{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			dates := []calendar.Date{}
+			for _, holiday := range testCase.rule.Holidays(testCase.year) {
+				dates = append(dates, holiday.Date)
+			}
+
+			s.Assert().Equal(testCase.expectedDates, dates)
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestCalculateDueDateWithHolidays() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+	})
+	s.Assert().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		submitAt               time.Time
+		turnaroundDurationHour float64
+
+		expectedResolvedAt time.Time
+		expectedErr        error
+	}{
+		{
+			name:                   "Holiday submitAt",
+			submitAt:               parseTimeRfc3339("2021-11-01T10:00:00+01:00"),
+			turnaroundDurationHour: 2,
+			expectedResolvedAt:     time.Time{},
+			expectedErr:            calendar.ErrInvalidSubmitTime,
+		},
+		{
+			name:                   "Short duration over holiday",
+			submitAt:               parseTimeRfc3339("2021-10-29T16:00:00+02:00"),
+			turnaroundDurationHour: 2,
+			expectedResolvedAt:     parseTimeRfc3339("2021-11-02T10:00:00+02:00"),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Holiday in the week",
+			submitAt:               parseTimeRfc3339("2021-10-27T09:30:00+02:00"),
+			turnaroundDurationHour: 40,
+			expectedResolvedAt:     parseTimeRfc3339("2021-11-04T09:30:00+02:00"),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Over Easter",
+			submitAt:               parseTimeRfc3339("2021-04-01T12:00:00+02:00"),
+			turnaroundDurationHour: 8,
+			expectedResolvedAt:     parseTimeRfc3339("2021-04-06T12:00:00+02:00"),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Over Christmas weeks",
+			submitAt:               parseTimeRfc3339("2021-12-15T09:30:00+01:00"),
+			turnaroundDurationHour: 80,
+			expectedResolvedAt:     parseTimeRfc3339("2021-12-29T09:30:00+01:00"),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Over Christmas on Monday",
+			submitAt:               parseTimeRfc3339("2022-12-21T09:30:00+01:00"),
+			turnaroundDurationHour: 40,
+			expectedResolvedAt:     parseTimeRfc3339("2022-12-29T09:30:00+01:00"),
+			expectedErr:            nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			resolvedAt, err := calendarTest.CalculateDueDate(testCase.submitAt, testCase.turnaroundDurationHour)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+
+			s.Assert().Equal(
+				testCase.expectedResolvedAt.Format(calendar.TimeFormatDefault),
+				resolvedAt.Format(calendar.TimeFormatDefault),
+			)
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestCalculateDueDateWithoutWorkingDays() {
+	if testing.Short() {
+		s.T().Skip("the working time of thousands of years is searched")
+	}
+
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays: []calendar.HolidayRule{calendar.HolidayFunc(func(year int) []calendar.Holiday {
+			// every workday is a holiday from 2022
+			if year < 2022 {
+				return nil
+			}
+
+			holidays := make([]calendar.Holiday, 0, 366) //nolint:gomnd // days of a leap year
+			for date := calendar.NewDate(year, time.January, 1); date.Year == year; date = date.AddDays(1) {
+				if weekday := date.Weekday(); weekday != time.Saturday && weekday != time.Sunday {
+					holidays = append(holidays, calendar.Holiday{Date: date, Name: "Closed", WorkBegins: 0, WorkEnds: 0})
+				}
+			}
+
+			return holidays
+		})},
+	})
+	s.Require().NoError(err)
+
+	submitAt := parseTimeRfc3339("2021-12-31T16:00:00+01:00")
+
+	for _, turnaroundDurationHour := range []float64{2, 16, 100, 100000} {
+		resolvedAt, err := calendarTest.CalculateDueDate(submitAt, turnaroundDurationHour)
+		s.Assert().ErrorIs(err, calendar.ErrInvalidHoliday, "turnaround: %f", turnaroundDurationHour)
+		s.Assert().True(resolvedAt.IsZero(), "turnaround: %f", turnaroundDurationHour)
+
+		_, err = calendarTest.CalculateDueDateTrace(submitAt, turnaroundDurationHour)
+		s.Assert().ErrorIs(err, calendar.ErrInvalidHoliday, "turnaround: %f", turnaroundDurationHour)
+
+		_, err = calendarTest.CalculateTimelineDueDate(*calendar.NewTimeline(submitAt), turnaroundDurationHour)
+		s.Assert().ErrorIs(err, calendar.ErrInvalidHoliday, "turnaround: %f", turnaroundDurationHour)
+	}
+
+	resolvedAt, err := calendarTest.CalculateDueDate(submitAt, 0.5)
+	s.Assert().NoError(err)
+	s.Assert().Equal("2021-12-31T16:30:00+01:00", resolvedAt.Format(time.RFC3339))
+}
+
+func (s *CalendarTestSuite) TestCalculateDueDateWithPartialHolidays() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays: append(hu.Holidays(),
+			calendar.PartialHoliday{
+				Rule:       calendar.FixedHoliday{Name: "Christmas Eve", Month: time.December, Day: 24},
+				WorkBegins: 9 * time.Hour,
+				WorkEnds:   13 * time.Hour,
+			},
+			calendar.PartialHoliday{
+				Rule:       calendar.FixedHoliday{Name: "New Year's Eve", Month: time.December, Day: 31},
+				WorkBegins: 9 * time.Hour,
+				WorkEnds:   13 * time.Hour,
+			},
+		),
+	})
+	s.Assert().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		submitAt               time.Time
+		turnaroundDurationHour float64
+
+		expectedResolvedAt time.Time
+		expectedErr        error
+	}{
+		{
+			name:                   "Too late submitAt on shortened day",
+			submitAt:               parseTimeRfc3339("2021-12-24T14:00:00+01:00"),
+			turnaroundDurationHour: 2,
+			expectedResolvedAt:     time.Time{},
+			expectedErr:            calendar.ErrInvalidSubmitTime,
+		},
+		{
+			name:                   "Same shortened day resolved",
+			submitAt:               parseTimeRfc3339("2021-12-24T10:00:00+01:00"),
+			turnaroundDurationHour: 2,
+			expectedResolvedAt:     parseTimeRfc3339("2021-12-24T12:00:00+01:00"),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Short duration over shortened day",
+			submitAt:               parseTimeRfc3339("2021-12-23T16:00:00+01:00"),
+			turnaroundDurationHour: 6,
+			expectedResolvedAt:     parseTimeRfc3339("2021-12-27T10:00:00+01:00"),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Weeks with shortened days",
+			submitAt:               parseTimeRfc3339("2021-12-20T09:30:00+01:00"),
+			turnaroundDurationHour: 76,
+			expectedResolvedAt:     parseTimeRfc3339("2022-01-03T13:30:00+01:00"),
+			expectedErr:            nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			resolvedAt, err := calendarTest.CalculateDueDate(testCase.submitAt, testCase.turnaroundDurationHour)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+
+			s.Assert().Equal(
+				testCase.expectedResolvedAt.Format(calendar.TimeFormatDefault),
+				resolvedAt.Format(calendar.TimeFormatDefault),
+			)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package calendar_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/hu"
+)
+
+func (s *CalendarTestSuite) TestCalculateDueDateLongTurnaround() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+	})
+	s.Require().NoError(err)
+
+	submitAt := parseTimeRfc3339("2021-10-13T09:30:00+02:00")
+
+	for _, turnaroundDurationHour := range []float64{10.5, 100, 1000.25, 10000, 100000.75} {
+		resolvedAt, err := calendarTest.CalculateDueDate(submitAt, turnaroundDurationHour)
+		s.Require().NoError(err)
+
+		s.Assert().Equal(
+			calendar.HourToDuration(turnaroundDurationHour),
+			calendarTest.WorkingDurationBetween(submitAt, resolvedAt),
+			"turnaround: %f", turnaroundDurationHour,
+		)
+	}
+}
+
+func (s *CalendarTestSuite) TestSubtractWorkingDuration() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+		IndexFromYear:  2021,
+		IndexToYear:    2022,
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		at       time.Time
+		duration time.Duration
+
+		expectedAt time.Time
+	}{
+		{
+			name:       "Same day",
+			at:         parseTimeRfc3339("2021-10-13T15:00:00+02:00"),
+			duration:   calendar.HourToDuration(5.5),
+			expectedAt: parseTimeRfc3339("2021-10-13T09:30:00+02:00"),
+		},
+		{
+			name:       "Beginning of the day",
+			at:         parseTimeRfc3339("2021-10-13T10:00:00+02:00"),
+			duration:   calendar.HourToDuration(1),
+			expectedAt: parseTimeRfc3339("2021-10-12T17:00:00+02:00"),
+		},
+		{
+			name:       "Off hours",
+			at:         parseTimeRfc3339("2021-10-13T20:00:00+02:00"),
+			duration:   calendar.HourToDuration(10),
+			expectedAt: parseTimeRfc3339("2021-10-12T15:00:00+02:00"),
+		},
+		{
+			name:       "Over holiday and weekend",
+			at:         parseTimeRfc3339("2021-10-25T10:00:00+02:00"),
+			duration:   calendar.HourToDuration(11),
+			expectedAt: parseTimeRfc3339("2021-10-21T15:00:00+02:00"),
+		},
+		{
+			name:       "Before the index",
+			at:         parseTimeRfc3339("2021-01-04T10:00:00+01:00"),
+			duration:   calendar.HourToDuration(17),
+			expectedAt: parseTimeRfc3339("2020-12-29T17:00:00+01:00"),
+		},
+		{
+			name:       "Zero",
+			at:         parseTimeRfc3339("2021-10-23T10:00:00+02:00"),
+			duration:   0,
+			expectedAt: parseTimeRfc3339("2021-10-23T10:00:00+02:00"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			s.Assert().Equal(
+				testCase.expectedAt.Format(time.RFC3339),
+				calendarTest.SubtractWorkingDuration(testCase.at, testCase.duration).Format(time.RFC3339),
+			)
+		})
+	}
+}
+
+func BenchmarkCalculateDueDateTurnaround(b *testing.B) {
+	calendarDefault, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	calendarIndexed, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+		IndexFromYear:  2021,
+		IndexToYear:    2080,
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	calendars := map[string]*calendar.Calendar{
+		"default":    calendarDefault,
+		"hu":         benchmarkCalendar(b),
+		"hu-indexed": calendarIndexed,
+	}
+
+	submitAt := parseTimeRfc3339("2021-10-13T09:30:00+02:00")
+
+	for _, name := range []string{"default", "hu", "hu-indexed"} {
+		calendarBenchmark := calendars[name]
+
+		for _, turnaroundDurationHour := range []float64{
+			10.5, 100.5, 1000.5, 10000.5, 100000.5, 1000000.5, math.Floor(math.MaxInt64 / float64(time.Hour)),
+		} {
+			turnaroundDurationHour := turnaroundDurationHour
+
+			b.Run(fmt.Sprintf("%s/%.1fh", name, turnaroundDurationHour), func(b *testing.B) {
+				// the working-time index of the years is built by the first call
+				if _, err := calendarBenchmark.CalculateDueDate(submitAt, turnaroundDurationHour); err != nil {
+					b.Fatal(err)
+				}
+
+				b.ResetTimer()
+
+				for n := 0; n < b.N; n++ {
+					_, _ = calendarBenchmark.CalculateDueDate(submitAt, turnaroundDurationHour)
+				}
+			})
+		}
+	}
+}
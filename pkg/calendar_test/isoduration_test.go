@@ -0,0 +1,95 @@
+package calendar_test
+
+import (
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+func (s *CalendarTestSuite) TestParseISODuration() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		value string
+
+		expectedDuration time.Duration
+		expectedErr      error
+	}{
+		{name: "Days and hours", value: "P2DT4H", expectedDuration: 20 * time.Hour, expectedErr: nil},
+		{name: "Week", value: "P1W", expectedDuration: 40 * time.Hour, expectedErr: nil},
+		{name: "Week and day", value: "P1W1D", expectedDuration: 48 * time.Hour, expectedErr: nil},
+		{name: "Time", value: "PT1H30M15S", expectedDuration: 90*time.Minute + 15*time.Second, expectedErr: nil},
+		{name: "Fraction", value: "P1.5D", expectedDuration: 12 * time.Hour, expectedErr: nil},
+		{name: "Comma fraction", value: "PT0,5H", expectedDuration: 30 * time.Minute, expectedErr: nil},
+		{name: "Zero", value: "PT0S", expectedDuration: 0, expectedErr: nil},
+		{name: "Negative", value: "-PT2H", expectedDuration: -2 * time.Hour, expectedErr: nil},
+		{name: "Missing P", value: "2DT4H", expectedDuration: 0, expectedErr: calendar.ErrInvalidDuration},
+		{name: "Empty", value: "P", expectedDuration: 0, expectedErr: calendar.ErrInvalidDuration},
+		{name: "Empty time", value: "P1DT", expectedDuration: 0, expectedErr: calendar.ErrInvalidDuration},
+		{name: "Year", value: "P1Y", expectedDuration: 0, expectedErr: calendar.ErrInvalidDuration},
+		{name: "Month", value: "P1M", expectedDuration: 0, expectedErr: calendar.ErrInvalidDuration},
+		{name: "Order", value: "PT1M1H", expectedDuration: 0, expectedErr: calendar.ErrInvalidDuration},
+		{name: "Fraction not last", value: "P1.5DT1H", expectedDuration: 0, expectedErr: calendar.ErrInvalidDuration},
+		{name: "Missing value", value: "PDT1H", expectedDuration: 0, expectedErr: calendar.ErrInvalidDuration},
+		{name: "Missing designator", value: "PT1", expectedDuration: 0, expectedErr: calendar.ErrInvalidDuration},
+		{name: "Invalid number", value: "P1..5D", expectedDuration: 0, expectedErr: calendar.ErrInvalidDuration},
+		{name: "Too long", value: "P1000000W", expectedDuration: 0, expectedErr: calendar.ErrInvalidDuration},
+		{name: "Too long sum", value: "P60000WT1000000H", expectedDuration: 0, expectedErr: calendar.ErrInvalidDuration},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			duration, err := calendarTest.ParseISODuration(testCase.value)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+			s.Assert().Equal(testCase.expectedDuration, duration)
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestFormatISODuration() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		duration time.Duration
+
+		expectedValue string
+	}{
+		{name: "Days and hours", duration: 20 * time.Hour, expectedValue: "P2DT4H"},
+		{name: "Days", duration: 16 * time.Hour, expectedValue: "P2D"},
+		{name: "Time", duration: 90*time.Minute + 1500*time.Millisecond, expectedValue: "PT1H30M1.5S"},
+		{name: "Zero", duration: 0, expectedValue: "PT0S"},
+		{name: "Negative", duration: -9 * time.Hour, expectedValue: "-P1DT1H"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			value := calendarTest.FormatISODuration(testCase.duration)
+			s.Assert().Equal(testCase.expectedValue, value)
+
+			duration, err := calendarTest.ParseISODuration(value)
+			s.Require().NoError(err)
+			s.Assert().Equal(testCase.duration, duration)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package calendar_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/hu"
+)
+
+func (s *CalendarTestSuite) TestParseLocale() {
+	testCases := []struct {
+		tag string
+
+		expectedLocale    calendar.Locale
+		expectedSupported bool
+	}{
+		{tag: "hu", expectedLocale: calendar.LocaleHungarian, expectedSupported: true},
+		{tag: "hu-HU", expectedLocale: calendar.LocaleHungarian, expectedSupported: true},
+		{tag: "de_DE.UTF-8", expectedLocale: calendar.LocaleGerman, expectedSupported: true},
+		{tag: " EN-us", expectedLocale: calendar.LocaleEnglish, expectedSupported: true},
+		{tag: "fr", expectedLocale: calendar.LocaleEnglish, expectedSupported: false},
+		{tag: "", expectedLocale: calendar.LocaleEnglish, expectedSupported: false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.tag, func() {
+			locale, supported := calendar.ParseLocale(testCase.tag)
+
+			s.Assert().Equal(testCase.expectedLocale, locale)
+			s.Assert().Equal(testCase.expectedSupported, supported)
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestLocaleWeekday() {
+	s.Assert().Equal("Monday", calendar.LocaleEnglish.Weekday(time.Monday))
+	s.Assert().Equal("hétfő", calendar.LocaleHungarian.Weekday(time.Monday))
+	s.Assert().Equal("Sonntag", calendar.LocaleGerman.Weekday(time.Sunday))
+	s.Assert().Equal("Saturday", calendar.Locale("fr").Weekday(time.Saturday))
+}
+
+func (s *CalendarTestSuite) TestLocaleMessage() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+		Overrides: []calendar.DayOverride{
+			{Date: calendar.NewDate(2021, time.December, 24), Working: false},
+		},
+	})
+	s.Require().NoError(err)
+
+	_, errConfig := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   time.Saturday,
+		WorkdaysInWeek: 2,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().Error(errConfig)
+
+	submitAtErr := func(submitAt string) error {
+		_, err := calendarTest.CalculateDueDate(parseTimeRfc3339(submitAt), 1)
+
+		return err
+	}
+
+	testCases := []struct {
+		name string
+
+		err error
+
+		expectedMessages map[calendar.Locale]string
+	}{
+		{
+			name: "Day off",
+			err:  submitAtErr("2021-12-24T10:00:00+01:00"),
+			expectedMessages: map[calendar.Locale]string{
+				calendar.LocaleEnglish:   "invalid submit datetime: 2021-12-24T10:00:00+01:00, 2021-12-24 is not a workday",
+				calendar.LocaleHungarian: "érvénytelen beküldési időpont: 2021.12.24. 10:00, 2021-12-24 nem munkanap",
+				calendar.LocaleGerman:    "ungültiger Einreichungszeitpunkt: 24.12.2021 10:00, 2021-12-24 ist kein Arbeitstag",
+			},
+		},
+		{
+			name: "Weekend",
+			err:  submitAtErr("2021-12-18T10:00:00+01:00"),
+			expectedMessages: map[calendar.Locale]string{
+				calendar.LocaleEnglish:   "invalid submit datetime: 2021-12-18T10:00:00+01:00, must be Monday - Friday",
+				calendar.LocaleHungarian: "érvénytelen beküldési időpont: 2021.12.18. 10:00, hétfő és péntek között kell lennie",
+				calendar.LocaleGerman:    "ungültiger Einreichungszeitpunkt: 18.12.2021 10:00, muss zwischen Montag und Freitag liegen",
+			},
+		},
+		{
+			name: "Holiday",
+			err:  submitAtErr("2021-11-01T10:00:00+01:00"),
+			expectedMessages: map[calendar.Locale]string{
+				calendar.LocaleEnglish:   "invalid submit datetime: 2021-11-01T10:00:00+01:00, Mindenszentek is a holiday",
+				calendar.LocaleHungarian: "érvénytelen beküldési időpont: 2021.11.01. 10:00, Mindenszentek ünnepnap",
+				calendar.LocaleGerman:    "ungültiger Einreichungszeitpunkt: 01.11.2021 10:00, Mindenszentek ist ein Feiertag",
+			},
+		},
+		{
+			name: "Out of working hours",
+			err:  submitAtErr("2021-11-02T08:00:00+01:00"),
+			expectedMessages: map[calendar.Locale]string{
+				calendar.LocaleEnglish: "invalid submit datetime: 2021-11-02T08:00:00+01:00, " +
+					"must be 2021-11-02T09:00:00+01:00 - 2021-11-02T17:00:00+01:00",
+				calendar.LocaleHungarian: "érvénytelen beküldési időpont: 2021.11.02. 08:00, " +
+					"2021.11.02. 09:00 és 2021.11.02. 17:00 között kell lennie",
+				calendar.LocaleGerman: "ungültiger Einreichungszeitpunkt: 02.11.2021 08:00, " +
+					"muss zwischen 02.11.2021 09:00 und 02.11.2021 17:00 liegen",
+			},
+		},
+		{
+			name: "Config",
+			err:  errConfig,
+			expectedMessages: map[calendar.Locale]string{
+				calendar.LocaleEnglish:   "invalid workdays: Saturday + 2",
+				calendar.LocaleHungarian: "érvénytelen munkanapok: Saturday + 2",
+				calendar.LocaleGerman:    "ungültige Arbeitstage: Saturday + 2",
+			},
+		},
+		{
+			name: "Other",
+			err:  errors.New("other error"),
+			expectedMessages: map[calendar.Locale]string{
+				calendar.LocaleEnglish:   "other error",
+				calendar.LocaleHungarian: "other error",
+				calendar.LocaleGerman:    "other error",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			for locale, expectedMessage := range testCase.expectedMessages {
+				s.Assert().Equal(expectedMessage, locale.Message(testCase.err), locale)
+			}
+
+			s.Assert().Equal(testCase.err.Error(), calendar.LocaleEnglish.Message(testCase.err))
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestLocaleMessageSentinels() {
+	sentinels := []error{
+		calendar.ErrInvalidWorkdays, calendar.ErrInvalidWorkTime, calendar.ErrInvalidSubmitTime,
+		calendar.ErrInvalidTimeFormat, calendar.ErrInvalidHoliday, calendar.ErrInvalidOverride,
+		calendar.ErrInvalidIndexYears, calendar.ErrInvalidTimeString, calendar.ErrInvalidTurnaround,
+		calendar.ErrInvalidTimeline, calendar.ErrTimelinePaused, calendar.ErrInvalidFraction,
+		calendar.ErrInvalidPhrase, calendar.ErrAmbiguousPhrase, calendar.ErrInvalidDuration,
+		calendar.ErrInvalidWorkingDuration, calendar.ErrNilCalendar,
+	}
+
+	for _, sentinel := range sentinels {
+		err := fmt.Errorf("%w: details", sentinel)
+
+		s.Assert().Equal(err.Error(), calendar.LocaleEnglish.Message(err))
+
+		for _, locale := range []calendar.Locale{calendar.LocaleHungarian, calendar.LocaleGerman} {
+			message := locale.Message(err)
+
+			s.Assert().NotEqual(err.Error(), message, "%s: %s", locale, sentinel)
+			s.Assert().True(strings.HasSuffix(message, ": details"), "%s: %s", locale, message)
+		}
+	}
+}
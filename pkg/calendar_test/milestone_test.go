@@ -0,0 +1,128 @@
+package calendar_test
+
+import (
+	"math"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/hu"
+)
+
+func (s *CalendarTestSuite) TestCalculateMilestones() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		submitAt               time.Time
+		turnaroundDurationHour float64
+		fractions              []float64
+
+		expectedMilestones []time.Time
+		expectedErr        error
+	}{
+		{
+			name:                   "Escalations",
+			submitAt:               parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+			turnaroundDurationHour: 40,
+			fractions:              []float64{0.5, 0.75, 0.9, 1},
+			expectedMilestones: []time.Time{
+				parseTimeRfc3339("2021-10-22T14:00:00+02:00"),
+				parseTimeRfc3339("2021-10-25T16:00:00+02:00"),
+				parseTimeRfc3339("2021-10-26T14:00:00+02:00"),
+				parseTimeRfc3339("2021-10-27T10:00:00+02:00"),
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                   "Not ordered",
+			submitAt:               parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+			turnaroundDurationHour: 8,
+			fractions:              []float64{1, 0, 0.5},
+			expectedMilestones: []time.Time{
+				parseTimeRfc3339("2021-10-21T10:00:00+02:00"),
+				parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+				parseTimeRfc3339("2021-10-20T14:00:00+02:00"),
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                   "Negative fraction",
+			submitAt:               parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+			turnaroundDurationHour: 8,
+			fractions:              []float64{-0.5},
+			expectedMilestones:     nil,
+			expectedErr:            calendar.ErrInvalidFraction,
+		},
+		{
+			name:                   "Rounded to nanoseconds",
+			submitAt:               parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+			turnaroundDurationHour: 1,
+			fractions:              []float64{1.0 / 7},
+			expectedMilestones:     []time.Time{parseTimeRfc3339("2021-10-20T10:08:34.285714286+02:00")},
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Not a number fraction",
+			submitAt:               parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+			turnaroundDurationHour: 8,
+			fractions:              []float64{math.NaN()},
+			expectedMilestones:     nil,
+			expectedErr:            calendar.ErrInvalidFraction,
+		},
+		{
+			name:                   "Infinite fraction",
+			submitAt:               parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+			turnaroundDurationHour: 8,
+			fractions:              []float64{math.Inf(1)},
+			expectedMilestones:     nil,
+			expectedErr:            calendar.ErrInvalidFraction,
+		},
+		{
+			name:                   "Too large fraction",
+			submitAt:               parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+			turnaroundDurationHour: 8,
+			fractions:              []float64{1e300},
+			expectedMilestones:     nil,
+			expectedErr:            calendar.ErrInvalidFraction,
+		},
+		{
+			name:                   "Invalid submit time",
+			submitAt:               parseTimeRfc3339("2021-10-23T10:00:00+02:00"),
+			turnaroundDurationHour: 8,
+			fractions:              []float64{0.5},
+			expectedMilestones:     nil,
+			expectedErr:            calendar.ErrInvalidSubmitTime,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			milestones, err := calendarTest.CalculateMilestones(
+				testCase.submitAt, testCase.turnaroundDurationHour, testCase.fractions,
+			)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+			s.Assert().Equal(testCase.expectedMilestones, milestones)
+
+			for f, fraction := range testCase.fractions {
+				if fraction != 1 || err != nil {
+					continue
+				}
+
+				dueAt, err := calendarTest.CalculateDueDate(testCase.submitAt, testCase.turnaroundDurationHour)
+				s.Require().NoError(err)
+				s.Assert().Equal(dueAt, milestones[f])
+			}
+		})
+	}
+}
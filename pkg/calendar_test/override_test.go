@@ -0,0 +1,130 @@
+package calendar_test
+
+import (
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/hu"
+)
+
+func (s *CalendarTestSuite) TestCalculateDueDateWithOverrides() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+		Overrides: []calendar.DayOverride{
+			{Date: calendar.NewDate(2021, time.December, 11), Working: true},
+			{Date: calendar.NewDate(2021, time.December, 24), Working: false},
+			{
+				Date:       calendar.NewDate(2022, time.January, 8),
+				Working:    true,
+				WorkBegins: 8 * time.Hour,
+				WorkEnds:   12 * time.Hour,
+			},
+		},
+	})
+	s.Assert().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		submitAt               time.Time
+		turnaroundDurationHour float64
+
+		expectedResolvedAt time.Time
+		expectedErr        error
+	}{
+		{
+			name:                   "Non-working override submitAt",
+			submitAt:               parseTimeRfc3339("2021-12-24T10:00:00+01:00"),
+			turnaroundDurationHour: 2,
+			expectedResolvedAt:     time.Time{},
+			expectedErr:            calendar.ErrInvalidSubmitTime,
+		},
+		{
+			name:                   "Too early submitAt on own hours",
+			submitAt:               parseTimeRfc3339("2022-01-08T07:30:00+01:00"),
+			turnaroundDurationHour: 2,
+			expectedResolvedAt:     time.Time{},
+			expectedErr:            calendar.ErrInvalidSubmitTime,
+		},
+		{
+			name:                   "Working Saturday submitAt",
+			submitAt:               parseTimeRfc3339("2021-12-11T10:00:00+01:00"),
+			turnaroundDurationHour: 2,
+			expectedResolvedAt:     parseTimeRfc3339("2021-12-11T12:00:00+01:00"),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Short duration to working Saturday",
+			submitAt:               parseTimeRfc3339("2021-12-10T16:00:00+01:00"),
+			turnaroundDurationHour: 2,
+			expectedResolvedAt:     parseTimeRfc3339("2021-12-11T10:00:00+01:00"),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Week with working Saturday",
+			submitAt:               parseTimeRfc3339("2021-12-08T09:30:00+01:00"),
+			turnaroundDurationHour: 48,
+			expectedResolvedAt:     parseTimeRfc3339("2021-12-15T09:30:00+01:00"),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Over bridge day and Christmas",
+			submitAt:               parseTimeRfc3339("2021-12-23T16:00:00+01:00"),
+			turnaroundDurationHour: 2,
+			expectedResolvedAt:     parseTimeRfc3339("2021-12-27T10:00:00+01:00"),
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Over own hours",
+			submitAt:               parseTimeRfc3339("2022-01-07T16:00:00+01:00"),
+			turnaroundDurationHour: 6,
+			expectedResolvedAt:     parseTimeRfc3339("2022-01-10T10:00:00+01:00"),
+			expectedErr:            nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			resolvedAt, err := calendarTest.CalculateDueDate(testCase.submitAt, testCase.turnaroundDurationHour)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+
+			s.Assert().Equal(
+				testCase.expectedResolvedAt.Format(calendar.TimeFormatDefault),
+				resolvedAt.Format(calendar.TimeFormatDefault),
+			)
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestConfigSlicesCopied() {
+	holidays := []calendar.HolidayRule{calendar.FixedHoliday{Name: "Holiday", Month: time.November, Day: 2}}
+	overrides := []calendar.DayOverride{{Date: calendar.NewDate(2021, time.November, 3), Working: false}}
+
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       holidays,
+		Overrides:      overrides,
+	})
+	s.Require().NoError(err)
+
+	holidays[0] = calendar.FixedHoliday{Name: "Changed", Month: time.November, Day: 4}
+	overrides[0] = calendar.DayOverride{Date: calendar.NewDate(2021, time.November, 5), Working: false}
+
+	s.Assert().True(calendarTest.IsHoliday(calendar.NewDate(2021, time.November, 2)))
+	s.Assert().False(calendarTest.IsHoliday(calendar.NewDate(2021, time.November, 4)))
+
+	resolvedAt, err := calendarTest.CalculateDueDate(parseTimeRfc3339("2021-11-01T16:00:00+01:00"), 2)
+	s.Require().NoError(err)
+	s.Assert().Equal(parseTimeRfc3339("2021-11-04T10:00:00+01:00"), resolvedAt)
+}
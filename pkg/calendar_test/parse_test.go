@@ -0,0 +1,146 @@
+package calendar_test
+
+import (
+	"time"
+	_ "time/tzdata" // Europe/Budapest is needed without system tzdata
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+func (s *CalendarTestSuite) TestParseTime() {
+	budapest, err := time.LoadLocation("Europe/Budapest")
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		timeFormat string
+		location   *time.Location
+		value      string
+
+		expectedTime time.Time
+		expectedErr  error
+	}{
+		{
+			name:         "RFC 3339",
+			timeFormat:   calendar.TimeFormatDefault,
+			location:     nil,
+			value:        "2021-10-13T09:30:00+04:00",
+			expectedTime: parseTimeRfc3339("2021-10-13T09:30:00+04:00"),
+			expectedErr:  nil,
+		},
+		{
+			name:         "Without zone",
+			timeFormat:   "2006-01-02 15:04",
+			location:     nil,
+			value:        "2021-10-13 09:30",
+			expectedTime: time.Date(2021, time.October, 13, 9, 30, 0, 0, time.UTC),
+			expectedErr:  nil,
+		},
+		{
+			name:         "Without zone in location",
+			timeFormat:   "2006-01-02 15:04",
+			location:     budapest,
+			value:        "2021-10-13 09:30",
+			expectedTime: time.Date(2021, time.October, 13, 9, 30, 0, 0, budapest),
+			expectedErr:  nil,
+		},
+		{
+			name:         "Converted to location",
+			timeFormat:   calendar.TimeFormatDefault,
+			location:     budapest,
+			value:        "2021-10-13T09:30:00Z",
+			expectedTime: time.Date(2021, time.October, 13, 11, 30, 0, 0, budapest),
+			expectedErr:  nil,
+		},
+		{
+			name:         "Invalid",
+			timeFormat:   "2006-01-02 15:04",
+			location:     budapest,
+			value:        "2021-10-13T09:30:00Z",
+			expectedTime: time.Time{},
+			expectedErr:  calendar.ErrInvalidTimeString,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			calendarTest, err := calendar.NewCalendar(calendar.Config{
+				FirstWorkday:   calendar.FirstWorkdayDefault,
+				WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+				WorkBegins:     calendar.WorkBeginsDefault,
+				WorkEnds:       calendar.WorkEndsDefault,
+				TimeFormat:     testCase.timeFormat,
+				Location:       testCase.location,
+			})
+			s.Require().NoError(err)
+
+			parsed, err := calendarTest.ParseTime(testCase.value)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+			s.Assert().True(testCase.expectedTime.Equal(parsed), "%s != %s", testCase.expectedTime, parsed)
+
+			if testCase.location != nil && err == nil {
+				s.Assert().Equal(testCase.location, parsed.Location())
+			}
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestCalculateDueDateString() {
+	budapest, err := time.LoadLocation("Europe/Budapest")
+	s.Require().NoError(err)
+
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     "2006.01.02. 15:04",
+		Location:       budapest,
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		submitAt               string
+		turnaroundDurationHour float64
+
+		expectedDueAt string
+		expectedErr   error
+	}{
+		{
+			name:                   "Over DST end",
+			submitAt:               "2021.10.29. 16:00",
+			turnaroundDurationHour: 2,
+			expectedDueAt:          "2021.11.01. 10:00",
+			expectedErr:            nil,
+		},
+		{
+			name:                   "Invalid string",
+			submitAt:               "2021-10-29T16:00:00+02:00",
+			turnaroundDurationHour: 2,
+			expectedDueAt:          "",
+			expectedErr:            calendar.ErrInvalidTimeString,
+		},
+		{
+			name:                   "Invalid submit time",
+			submitAt:               "2021.10.30. 10:00",
+			turnaroundDurationHour: 2,
+			expectedDueAt:          "",
+			expectedErr:            calendar.ErrInvalidSubmitTime,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			dueAt, err := calendarTest.CalculateDueDateString(testCase.submitAt, testCase.turnaroundDurationHour)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+			s.Assert().Equal(testCase.expectedDueAt, dueAt)
+		})
+	}
+}
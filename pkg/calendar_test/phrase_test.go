@@ -0,0 +1,204 @@
+package calendar_test
+
+import (
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+func (s *CalendarTestSuite) TestParsePhrase() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().NoError(err)
+
+	now := parseTimeRfc3339("2021-10-13T14:00:00+02:00") // Wednesday
+
+	testCases := []struct {
+		name string
+
+		text string
+
+		expectedPhrase calendar.Phrase
+		expectedDueAt  time.Time
+		expectedErr    error
+	}{
+		{
+			name: "Business days from now",
+			text: "3 business days from now",
+			expectedPhrase: calendar.Phrase{
+				SubmitAt:   now,
+				Turnaround: 24 * time.Hour,
+			},
+			expectedDueAt: parseTimeRfc3339("2021-10-18T14:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name: "Next weekday with clock",
+			text: "next Tuesday 10am + 4h",
+			expectedPhrase: calendar.Phrase{
+				SubmitAt:   parseTimeRfc3339("2021-10-19T10:00:00+02:00"),
+				Turnaround: 4 * time.Hour,
+			},
+			expectedDueAt: parseTimeRfc3339("2021-10-19T14:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name: "Next same weekday",
+			text: "next wednesday 5pm",
+			expectedPhrase: calendar.Phrase{
+				SubmitAt:   parseTimeRfc3339("2021-10-20T17:00:00+02:00"),
+				Turnaround: 0,
+			},
+			expectedDueAt: parseTimeRfc3339("2021-10-20T17:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name: "In hours and minutes",
+			text: "in 2h30m",
+			expectedPhrase: calendar.Phrase{
+				SubmitAt:   now,
+				Turnaround: 150 * time.Minute,
+			},
+			expectedDueAt: parseTimeRfc3339("2021-10-13T16:30:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name: "Tomorrow at clock plus working week",
+			text: "Tomorrow at 9:30 + 1 working week",
+			expectedPhrase: calendar.Phrase{
+				SubmitAt:   parseTimeRfc3339("2021-10-14T09:30:00+02:00"),
+				Turnaround: 40 * time.Hour,
+			},
+			expectedDueAt: parseTimeRfc3339("2021-10-21T09:30:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name: "Weekday without clock",
+			text: "friday+1.5 hours",
+			expectedPhrase: calendar.Phrase{
+				SubmitAt:   parseTimeRfc3339("2021-10-15T09:00:00+02:00"),
+				Turnaround: 90 * time.Minute,
+			},
+			expectedDueAt: parseTimeRfc3339("2021-10-15T10:30:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name: "Today without clock",
+			text: "today + 1 workday",
+			expectedPhrase: calendar.Phrase{
+				SubmitAt:   now,
+				Turnaround: 8 * time.Hour,
+			},
+			expectedDueAt: parseTimeRfc3339("2021-10-14T14:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name: "From weekend",
+			text: "4h from saturday",
+			expectedPhrase: calendar.Phrase{
+				SubmitAt:   parseTimeRfc3339("2021-10-18T09:00:00+02:00"),
+				Turnaround: 4 * time.Hour,
+			},
+			expectedDueAt: parseTimeRfc3339("2021-10-18T13:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name:           "Same weekday",
+			text:           "wednesday 10am",
+			expectedPhrase: calendar.Phrase{},
+			expectedDueAt:  time.Time{},
+			expectedErr:    calendar.ErrAmbiguousPhrase,
+		},
+		{
+			name:           "Next weekday is tomorrow",
+			text:           "next thursday 10am",
+			expectedPhrase: calendar.Phrase{},
+			expectedDueAt:  time.Time{},
+			expectedErr:    calendar.ErrAmbiguousPhrase,
+		},
+		{
+			name:           "Bare hour",
+			text:           "next tuesday 10 + 4h",
+			expectedPhrase: calendar.Phrase{},
+			expectedDueAt:  time.Time{},
+			expectedErr:    calendar.ErrAmbiguousPhrase,
+		},
+		{
+			name:           "Calendar days",
+			text:           "3 days from now",
+			expectedPhrase: calendar.Phrase{},
+			expectedDueAt:  time.Time{},
+			expectedErr:    calendar.ErrAmbiguousPhrase,
+		},
+		{
+			name:           "Unknown moment",
+			text:           "next week",
+			expectedPhrase: calendar.Phrase{},
+			expectedDueAt:  time.Time{},
+			expectedErr:    calendar.ErrInvalidPhrase,
+		},
+		{
+			name:           "Invalid clock",
+			text:           "today 13pm",
+			expectedPhrase: calendar.Phrase{},
+			expectedDueAt:  time.Time{},
+			expectedErr:    calendar.ErrInvalidPhrase,
+		},
+		{
+			name:           "Invalid character",
+			text:           "now + -4h",
+			expectedPhrase: calendar.Phrase{},
+			expectedDueAt:  time.Time{},
+			expectedErr:    calendar.ErrInvalidPhrase,
+		},
+		{
+			name:           "Missing duration",
+			text:           "now +",
+			expectedPhrase: calendar.Phrase{},
+			expectedDueAt:  time.Time{},
+			expectedErr:    calendar.ErrInvalidPhrase,
+		},
+		{
+			name:           "Too long duration",
+			text:           "now + 3000000h",
+			expectedPhrase: calendar.Phrase{},
+			expectedDueAt:  time.Time{},
+			expectedErr:    calendar.ErrInvalidPhrase,
+		},
+		{
+			name:           "Too long sum of durations",
+			text:           "now + 2000000h 2000000h",
+			expectedPhrase: calendar.Phrase{},
+			expectedDueAt:  time.Time{},
+			expectedErr:    calendar.ErrInvalidPhrase,
+		},
+		{
+			name:           "Trailing words",
+			text:           "now 4h",
+			expectedPhrase: calendar.Phrase{},
+			expectedDueAt:  time.Time{},
+			expectedErr:    calendar.ErrInvalidPhrase,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			phrase, err := calendarTest.ParsePhrase(testCase.text, now)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+			s.Assert().Equal(testCase.expectedPhrase.SubmitAt.Format(time.RFC3339), phrase.SubmitAt.Format(time.RFC3339))
+			s.Assert().Equal(testCase.expectedPhrase.Turnaround, phrase.Turnaround)
+
+			dueAt, err := calendarTest.CalculatePhraseDueDate(testCase.text, now)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+			s.Assert().Equal(testCase.expectedDueAt.Format(time.RFC3339), dueAt.Format(time.RFC3339))
+		})
+	}
+}
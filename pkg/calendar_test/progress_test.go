@@ -0,0 +1,140 @@
+package calendar_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+func (s *CalendarTestSuite) TestCalculateProgress() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().NoError(err)
+
+	submitAt := parseTimeRfc3339("2021-10-13T10:00:00+02:00")
+
+	testCases := []struct {
+		name string
+
+		turnaroundDurationHour float64
+		at                     time.Time
+
+		expectedProgress calendar.Progress
+	}{
+		{
+			name:                   "Before submit",
+			turnaroundDurationHour: 16,
+			at:                     parseTimeRfc3339("2021-10-13T09:00:00+02:00"),
+			expectedProgress: calendar.Progress{
+				Turnaround:      16 * time.Hour,
+				Consumed:        0,
+				Remaining:       16 * time.Hour,
+				PercentConsumed: 0,
+				Status:          calendar.StatusOnTrack,
+			},
+		},
+		{
+			name:                   "On track",
+			turnaroundDurationHour: 16,
+			at:                     parseTimeRfc3339("2021-10-13T12:00:00+02:00"),
+			expectedProgress: calendar.Progress{
+				Turnaround:      16 * time.Hour,
+				Consumed:        2 * time.Hour,
+				Remaining:       14 * time.Hour,
+				PercentConsumed: 12.5,
+				Status:          calendar.StatusOnTrack,
+			},
+		},
+		{
+			name:                   "At risk",
+			turnaroundDurationHour: 16,
+			at:                     parseTimeRfc3339("2021-10-15T08:00:00+02:00"),
+			expectedProgress: calendar.Progress{
+				Turnaround:      16 * time.Hour,
+				Consumed:        15 * time.Hour,
+				Remaining:       time.Hour,
+				PercentConsumed: 93.75,
+				Status:          calendar.StatusAtRisk,
+			},
+		},
+		{
+			name:                   "At due date",
+			turnaroundDurationHour: 16,
+			at:                     parseTimeRfc3339("2021-10-15T10:00:00+02:00"),
+			expectedProgress: calendar.Progress{
+				Turnaround:      16 * time.Hour,
+				Consumed:        16 * time.Hour,
+				Remaining:       0,
+				PercentConsumed: 100,
+				Status:          calendar.StatusBreached,
+			},
+		},
+		{
+			name:                   "Breached by a working day",
+			turnaroundDurationHour: 16,
+			at:                     parseTimeRfc3339("2021-10-18T10:00:00+02:00"),
+			expectedProgress: calendar.Progress{
+				Turnaround:      16 * time.Hour,
+				Consumed:        24 * time.Hour,
+				Remaining:       -8 * time.Hour,
+				PercentConsumed: 150,
+				Status:          calendar.StatusBreached,
+			},
+		},
+		{
+			name:                   "Zero turnaround",
+			turnaroundDurationHour: 0,
+			at:                     submitAt,
+			expectedProgress: calendar.Progress{
+				Turnaround:      0,
+				Consumed:        0,
+				Remaining:       0,
+				PercentConsumed: 100,
+				Status:          calendar.StatusBreached,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			progress, err := calendarTest.CalculateProgress(
+				submitAt, testCase.turnaroundDurationHour, testCase.at, calendar.AtRiskPercentDefault,
+			)
+
+			s.Require().NoError(err)
+			s.Assert().Equal(testCase.expectedProgress, progress)
+		})
+	}
+
+	_, err = calendarTest.CalculateProgress(
+		parseTimeRfc3339("2021-10-16T10:00:00+02:00"), 16, submitAt, calendar.AtRiskPercentDefault,
+	)
+	s.Assert().ErrorIs(err, calendar.ErrInvalidSubmitTime)
+}
+
+func (s *CalendarTestSuite) TestStatus() {
+	s.Assert().Equal("on-track", calendar.StatusOnTrack.String())
+	s.Assert().Equal("at-risk", calendar.StatusAtRisk.String())
+	s.Assert().Equal("breached", calendar.StatusBreached.String())
+	s.Assert().Equal("unknown", calendar.Status(-1).String())
+
+	marshalled, err := json.Marshal(calendar.Progress{
+		Turnaround:      time.Hour,
+		Consumed:        time.Hour,
+		Remaining:       0,
+		PercentConsumed: 100,
+		Status:          calendar.StatusBreached,
+	})
+	s.Require().NoError(err)
+	s.Assert().JSONEq(
+		`{"turnaround": 3600000000000, "consumed": 3600000000000, "remaining": 0, "percentConsumed": 100, "status": "breached"}`,
+		string(marshalled),
+	)
+}
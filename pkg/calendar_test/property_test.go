@@ -0,0 +1,279 @@
+package calendar_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+	_ "time/tzdata" // the zones of the properties are needed without system tzdata
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+const (
+	propertyCases       = 300
+	propertyCasesShort  = 30
+	propertyMaxMinutes  = 200 * 60
+	propertyClockGrid   = 15 * time.Minute
+	propertyYearFrom    = 2021
+	propertyDaysInRange = 2 * 365
+)
+
+// propertyCase is a random calendar, submit time and turnaround in whole minutes.
+type propertyCase struct {
+	config   calendar.Config
+	calendar *calendar.Calendar
+	submitAt time.Time
+	minutes  int
+}
+
+func (propertyCase propertyCase) String() string {
+	return fmt.Sprintf("%s %d+%d %s-%s, holidays %d, overrides %v, submit %s, %d minutes",
+		propertyCase.config.FirstWorkday, propertyCase.config.FirstWorkday, propertyCase.config.WorkdaysInWeek,
+		propertyCase.config.WorkBegins, propertyCase.config.WorkEnds, len(propertyCase.config.Holidays),
+		propertyCase.config.Overrides, propertyCase.submitAt.Format(time.RFC3339), propertyCase.minutes,
+	)
+}
+
+func propertyLocations() []*time.Location {
+	locations := []*time.Location{
+		time.UTC,
+		time.FixedZone("+02:00", 2*60*60),
+		time.FixedZone("-05:30", -(5*60*60 + 30*60)),
+	}
+
+	for _, name := range []string{"Europe/Budapest", "America/New_York"} {
+		location, err := time.LoadLocation(name)
+		if err == nil {
+			locations = append(locations, location)
+		}
+	}
+
+	return locations
+}
+
+// randomClock returns a time of the day on the grid, between from and to (inclusive).
+func randomClock(random *rand.Rand, from, to time.Duration) time.Duration {
+	return from + time.Duration(random.Int63n(int64((to-from)/propertyClockGrid)+1))*propertyClockGrid
+}
+
+func randomDate(random *rand.Rand) calendar.Date {
+	return calendar.NewDate(propertyYearFrom, time.January, 1).AddDays(random.Intn(propertyDaysInRange))
+}
+
+// randomPropertyConfig returns a random calendar config in the location.
+func randomPropertyConfig(random *rand.Rand, location *time.Location) calendar.Config {
+	firstWorkday := time.Weekday(random.Intn(7))
+	config := calendar.Config{
+		FirstWorkday:   firstWorkday,
+		WorkdaysInWeek: 1 + random.Intn(7-int(firstWorkday)),
+		WorkBegins:     0,
+		WorkEnds:       0,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Location:       location,
+		Holidays:       []calendar.HolidayRule{},
+		Overrides:      []calendar.DayOverride{},
+	}
+	config.WorkBegins = randomClock(random, 0, 24*time.Hour-propertyClockGrid)
+	config.WorkEnds = randomClock(random, config.WorkBegins+propertyClockGrid, 24*time.Hour)
+
+	for h := random.Intn(10); h > 0; h-- {
+		date := randomDate(random)
+		holiday := calendar.HolidayRule(calendar.FixedHoliday{Name: "H" + date.String(), Month: date.Month, Day: date.Day})
+
+		if random.Intn(4) == 0 {
+			begins := randomClock(random, 0, 24*time.Hour-propertyClockGrid)
+			holiday = calendar.PartialHoliday{
+				Rule:       holiday,
+				WorkBegins: begins,
+				WorkEnds:   randomClock(random, begins+propertyClockGrid, 24*time.Hour),
+			}
+		}
+
+		config.Holidays = append(config.Holidays, holiday)
+	}
+
+	for o, dates := random.Intn(5), map[calendar.Date]bool{}; o > 0; o-- {
+		override := calendar.DayOverride{Date: randomDate(random), Working: random.Intn(2) == 0, WorkBegins: 0, WorkEnds: 0}
+		if dates[override.Date] {
+			continue
+		}
+
+		dates[override.Date] = true
+
+		if override.Working && random.Intn(2) == 0 {
+			override.WorkBegins = randomClock(random, 0, 24*time.Hour-propertyClockGrid)
+			override.WorkEnds = randomClock(random, override.WorkBegins+propertyClockGrid, 24*time.Hour)
+		}
+
+		config.Overrides = append(config.Overrides, override)
+	}
+
+	return config
+}
+
+// rejectedByDayLength reports whether the config must be rejected, because its working hours end after 23:00
+// in a location having daylight saving time.
+func rejectedByDayLength(config calendar.Config) bool {
+	_, winterOffset := time.Date(propertyYearFrom, time.January, 1, 0, 0, 0, 0, config.Location).Zone()
+	_, summerOffset := time.Date(propertyYearFrom, time.July, 1, 0, 0, 0, 0, config.Location).Zone()
+
+	if winterOffset == summerOffset {
+		return false
+	}
+
+	if config.WorkEnds > 23*time.Hour {
+		return true
+	}
+
+	for _, holiday := range config.Holidays {
+		if partial, is := holiday.(calendar.PartialHoliday); is && partial.WorkEnds > 23*time.Hour {
+			return true
+		}
+	}
+
+	for _, override := range config.Overrides {
+		if override.Working && override.WorkEnds > 23*time.Hour {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newPropertyCase returns a random case. The submit time is in the working hours of a random working day.
+// The configs having working hours after the shortest day of the location are checked to be rejected.
+func newPropertyCase(random *rand.Rand) (propertyCase, error) {
+	locations := propertyLocations()
+	location := locations[random.Intn(len(locations))]
+
+	config := randomPropertyConfig(random, location)
+	calendarRandom, err := calendar.NewCalendar(config)
+
+	for rejectedByDayLength(config) {
+		if !errors.Is(err, calendar.ErrInvalidWorkTime) {
+			return propertyCase{}, fmt.Errorf("working hours after the shortest day, not rejected: %v", err)
+		}
+
+		config = randomPropertyConfig(random, location)
+		calendarRandom, err = calendar.NewCalendar(config)
+	}
+
+	if err != nil {
+		return propertyCase{}, err
+	}
+
+	for {
+		date := randomDate(random)
+
+		begins, ends, working := calendarRandom.WorkHours(date)
+		if !working {
+			continue
+		}
+
+		submitClock := begins + time.Duration(random.Int63n(int64((ends-begins)/time.Minute)+1))*time.Minute
+		submitAt := date.In(location).Add(submitClock)
+
+		if calendar.DateOf(submitAt) != date {
+			// the end of the working hours at midnight is the next day
+			continue
+		}
+
+		return propertyCase{
+			config:   config,
+			calendar: calendarRandom,
+			submitAt: submitAt,
+			minutes:  random.Intn(propertyMaxMinutes + 1),
+		}, nil
+	}
+}
+
+// sameWorkingTime reports whether there is no working time between the times.
+func sameWorkingTime(calendarTest *calendar.Calendar, at, other time.Time) bool {
+	return calendarTest.ReferenceWorkingDuration(at, other) == 0
+}
+
+// checkDueDateProperties checks the due date of the case against the reference and the invariants.
+// The due date may be the beginning of the next working hours instead of the end of the consumed ones,
+// so the due dates are compared by the working time between them.
+func checkDueDateProperties(testCase propertyCase) error {
+	calendarTest := testCase.calendar
+	turnaround := float64(testCase.minutes) / 60
+
+	dueAt, err := calendarTest.CalculateDueDate(testCase.submitAt, turnaround)
+	if err != nil {
+		return fmt.Errorf("due date: %w", err)
+	}
+
+	expectedDueAt, err := calendarTest.ReferenceDueDate(testCase.submitAt, turnaround)
+	if err != nil {
+		return fmt.Errorf("reference due date: %w", err)
+	}
+
+	if dueAt.Before(expectedDueAt) || !sameWorkingTime(calendarTest, expectedDueAt, dueAt) {
+		return fmt.Errorf("due date %s, expected %s", dueAt.Format(time.RFC3339), expectedDueAt.Format(time.RFC3339))
+	}
+
+	begins, ends, working := calendarTest.WorkHours(calendar.DateOf(dueAt))
+	midnight := calendar.DateOf(dueAt).In(dueAt.Location())
+
+	if testCase.minutes > 0 && (!working || dueAt.Before(midnight.Add(begins)) || dueAt.After(midnight.Add(ends))) {
+		return fmt.Errorf("due date %s is out of the working hours", dueAt.Format(time.RFC3339))
+	}
+
+	duration := time.Duration(testCase.minutes) * time.Minute
+
+	if working := calendarTest.WorkingDurationBetween(testCase.submitAt, dueAt); working != duration {
+		return fmt.Errorf("working duration till the due date %s: %s", dueAt.Format(time.RFC3339), working)
+	}
+
+	submitAt := calendarTest.SubtractWorkingDuration(dueAt, duration)
+	if !sameWorkingTime(calendarTest, submitAt, testCase.submitAt) {
+		return fmt.Errorf("not inverse: %s - %s is %s", dueAt.Format(time.RFC3339), duration, submitAt.Format(time.RFC3339))
+	}
+
+	if testCase.minutes > 0 {
+		shorterDueAt, err := calendarTest.CalculateDueDate(testCase.submitAt, turnaround-1.0/60)
+		if err != nil || shorterDueAt.After(dueAt) {
+			return fmt.Errorf("not monotonic: %s is after %s (%v)",
+				shorterDueAt.Format(time.RFC3339), dueAt.Format(time.RFC3339), err,
+			)
+		}
+	}
+
+	firstMinutes := testCase.minutes / 3
+
+	firstDueAt, err := calendarTest.CalculateDueDate(testCase.submitAt, float64(firstMinutes)/60)
+	if err != nil {
+		return fmt.Errorf("first due date: %w", err)
+	}
+
+	additiveDueAt, err := calendarTest.CalculateDueDate(firstDueAt, float64(testCase.minutes-firstMinutes)/60)
+	if err != nil {
+		return fmt.Errorf("due date from %s: %w", firstDueAt.Format(time.RFC3339), err)
+	}
+
+	if !sameWorkingTime(calendarTest, additiveDueAt, dueAt) {
+		return fmt.Errorf("not additive: %s + %d minutes is %s", firstDueAt.Format(time.RFC3339),
+			testCase.minutes-firstMinutes, additiveDueAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func (s *CalendarTestSuite) TestDueDateProperties() {
+	random := rand.New(rand.NewSource(1)) //nolint:gosec // deterministic test data
+
+	cases := propertyCases
+	if testing.Short() {
+		cases = propertyCasesShort
+	}
+
+	for c := 0; c < cases; c++ {
+		testCase, err := newPropertyCase(random)
+		s.Require().NoError(err)
+
+		s.Require().NoError(checkDueDateProperties(testCase), "case #%d: %s", c, testCase)
+	}
+}
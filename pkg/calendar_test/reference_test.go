@@ -0,0 +1,161 @@
+package calendar_test
+
+import (
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+func (s *CalendarTestSuite) TestReferenceDueDate() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		submitAt               time.Time
+		turnaroundDurationHour float64
+
+		expectedDueAt time.Time
+		expectedErr   error
+	}{
+		{
+			name:                   "Same day",
+			submitAt:               parseTimeRfc3339("2021-11-02T14:12:00+01:00"),
+			turnaroundDurationHour: 2,
+			expectedDueAt:          parseTimeRfc3339("2021-11-02T16:12:00+01:00"),
+		},
+		{
+			name:                   "Over weekend",
+			submitAt:               parseTimeRfc3339("2021-11-05T14:12:00+01:00"),
+			turnaroundDurationHour: 16,
+			expectedDueAt:          parseTimeRfc3339("2021-11-09T14:12:00+01:00"),
+		},
+		{
+			name:                   "End of working hours",
+			submitAt:               parseTimeRfc3339("2021-11-02T09:00:00+01:00"),
+			turnaroundDurationHour: 8,
+			expectedDueAt:          parseTimeRfc3339("2021-11-02T17:00:00+01:00"),
+		},
+		{
+			name:                   "Seconds",
+			submitAt:               parseTimeRfc3339("2021-11-02T16:59:30+01:00"),
+			turnaroundDurationHour: 1.0 / 60,
+			expectedDueAt:          parseTimeRfc3339("2021-11-03T09:00:30+01:00"),
+		},
+		{
+			name:                   "Zero",
+			submitAt:               parseTimeRfc3339("2021-11-02T17:00:00+01:00"),
+			turnaroundDurationHour: 0,
+			expectedDueAt:          parseTimeRfc3339("2021-11-02T17:00:00+01:00"),
+		},
+		{
+			name:                   "Weekend submit",
+			submitAt:               parseTimeRfc3339("2021-11-06T10:00:00+01:00"),
+			turnaroundDurationHour: 1,
+			expectedErr:            calendar.ErrInvalidSubmitTime,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			dueAt, err := calendarTest.ReferenceDueDate(testCase.submitAt, testCase.turnaroundDurationHour)
+			if testCase.expectedErr != nil {
+				s.Assert().ErrorIs(err, testCase.expectedErr)
+
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Assert().Equal(testCase.expectedDueAt.String(), dueAt.String())
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestReferenceWorkingDuration() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		from time.Time
+		to   time.Time
+
+		expectedDuration time.Duration
+	}{
+		{
+			name:             "Over weekend",
+			from:             parseTimeRfc3339("2021-11-05T14:12:00+01:00"),
+			to:               parseTimeRfc3339("2021-11-08T10:00:00+01:00"),
+			expectedDuration: 3*time.Hour + 48*time.Minute,
+		},
+		{
+			name:             "Seconds",
+			from:             parseTimeRfc3339("2021-11-02T16:59:30+01:00"),
+			to:               parseTimeRfc3339("2021-11-03T09:00:15+01:00"),
+			expectedDuration: 45 * time.Second,
+		},
+		{
+			name:             "Negative",
+			from:             parseTimeRfc3339("2021-11-03T10:00:00+01:00"),
+			to:               parseTimeRfc3339("2021-11-02T16:00:00+01:00"),
+			expectedDuration: -2 * time.Hour,
+		},
+		{
+			name:             "Off hours",
+			from:             parseTimeRfc3339("2021-11-02T17:00:00+01:00"),
+			to:               parseTimeRfc3339("2021-11-03T09:00:00+01:00"),
+			expectedDuration: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			duration := calendarTest.ReferenceWorkingDuration(testCase.from, testCase.to)
+			s.Assert().Equal(testCase.expectedDuration, duration)
+			s.Assert().Equal(calendarTest.WorkingDurationBetween(testCase.from, testCase.to), duration)
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestReferenceSubMinuteWorkHours() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     9*time.Hour + 30*time.Second,
+		WorkEnds:       17*time.Hour + 30*time.Second,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().NoError(err)
+
+	submitAt := parseTimeRfc3339("2021-11-02T16:59:45+01:00")
+
+	for _, turnaroundDurationHour := range []float64{0.5 / 60, 1.0 / 60, 8.25} {
+		dueAt, err := calendarTest.CalculateDueDate(submitAt, turnaroundDurationHour)
+		s.Require().NoError(err)
+
+		referenceDueAt, err := calendarTest.ReferenceDueDate(submitAt, turnaroundDurationHour)
+		s.Require().NoError(err)
+
+		s.Assert().Equal(dueAt.String(), referenceDueAt.String(), "turnaround: %f", turnaroundDurationHour)
+		s.Assert().Equal(
+			calendar.HourToDuration(turnaroundDurationHour), calendarTest.ReferenceWorkingDuration(submitAt, dueAt),
+			"turnaround: %f", turnaroundDurationHour,
+		)
+	}
+}
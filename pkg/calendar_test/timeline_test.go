@@ -0,0 +1,190 @@
+package calendar_test
+
+import (
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+func (s *CalendarTestSuite) TestCalculateTimelineDueDate() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().NoError(err)
+
+	submitAt := parseTimeRfc3339("2021-10-13T10:00:00+02:00")
+
+	testCases := []struct {
+		name string
+
+		submitAt time.Time
+		pauses   []calendar.Pause
+
+		expectedDueAt time.Time
+		expectedErr   error
+	}{
+		{
+			name:          "Without pause",
+			submitAt:      submitAt,
+			pauses:        nil,
+			expectedDueAt: parseTimeRfc3339("2021-10-15T10:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name:     "Paused to next day",
+			submitAt: submitAt,
+			pauses: []calendar.Pause{
+				{PausedAt: parseTimeRfc3339("2021-10-13T12:00:00+02:00"), ResumedAt: parseTimeRfc3339("2021-10-14T14:00:00+02:00")},
+			},
+			expectedDueAt: parseTimeRfc3339("2021-10-18T12:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name:     "Resumed off hours",
+			submitAt: submitAt,
+			pauses: []calendar.Pause{
+				{PausedAt: parseTimeRfc3339("2021-10-13T12:00:00+02:00"), ResumedAt: parseTimeRfc3339("2021-10-13T20:00:00+02:00")},
+			},
+			expectedDueAt: parseTimeRfc3339("2021-10-15T15:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name:     "More pauses",
+			submitAt: submitAt,
+			pauses: []calendar.Pause{
+				{PausedAt: parseTimeRfc3339("2021-10-13T12:00:00+02:00"), ResumedAt: parseTimeRfc3339("2021-10-14T09:00:00+02:00")},
+				{PausedAt: parseTimeRfc3339("2021-10-14T11:00:00+02:00"), ResumedAt: parseTimeRfc3339("2021-10-14T13:00:00+02:00")},
+			},
+			expectedDueAt: parseTimeRfc3339("2021-10-18T09:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name:     "Paused after due date",
+			submitAt: submitAt,
+			pauses: []calendar.Pause{
+				{PausedAt: parseTimeRfc3339("2021-10-18T10:00:00+02:00"), ResumedAt: time.Time{}},
+			},
+			expectedDueAt: parseTimeRfc3339("2021-10-15T10:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name:     "Paused",
+			submitAt: submitAt,
+			pauses: []calendar.Pause{
+				{PausedAt: parseTimeRfc3339("2021-10-13T12:00:00+02:00"), ResumedAt: time.Time{}},
+			},
+			expectedDueAt: time.Time{},
+			expectedErr:   calendar.ErrTimelinePaused,
+		},
+		{
+			name:     "Resumed before paused",
+			submitAt: submitAt,
+			pauses: []calendar.Pause{
+				{PausedAt: parseTimeRfc3339("2021-10-13T12:00:00+02:00"), ResumedAt: parseTimeRfc3339("2021-10-13T11:00:00+02:00")},
+			},
+			expectedDueAt: time.Time{},
+			expectedErr:   calendar.ErrInvalidTimeline,
+		},
+		{
+			name:          "Submitted on weekend",
+			submitAt:      parseTimeRfc3339("2021-10-16T10:00:00+02:00"),
+			pauses:        nil,
+			expectedDueAt: time.Time{},
+			expectedErr:   calendar.ErrInvalidSubmitTime,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			dueAt, err := calendarTest.CalculateTimelineDueDate(
+				calendar.Timeline{SubmitAt: testCase.submitAt, Pauses: testCase.pauses}, 16,
+			)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+			s.Assert().Equal(testCase.expectedDueAt.Format(time.RFC3339), dueAt.Format(time.RFC3339))
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestRemainingWorkingDuration() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().NoError(err)
+
+	timeline := calendar.NewTimeline(parseTimeRfc3339("2021-10-13T10:00:00+02:00"))
+	s.Require().NoError(timeline.Pause(parseTimeRfc3339("2021-10-13T12:00:00+02:00")))
+	s.Require().NoError(timeline.Resume(parseTimeRfc3339("2021-10-14T14:00:00+02:00")))
+
+	testCases := []struct {
+		name string
+
+		at time.Time
+
+		expectedRemaining time.Duration
+	}{
+		{
+			name:              "Before submit",
+			at:                parseTimeRfc3339("2021-10-13T09:00:00+02:00"),
+			expectedRemaining: calendar.HourToDuration(16),
+		},
+		{
+			name:              "Running",
+			at:                parseTimeRfc3339("2021-10-13T11:00:00+02:00"),
+			expectedRemaining: calendar.HourToDuration(15),
+		},
+		{
+			name:              "Paused",
+			at:                parseTimeRfc3339("2021-10-14T10:00:00+02:00"),
+			expectedRemaining: calendar.HourToDuration(14),
+		},
+		{
+			name:              "Resumed",
+			at:                parseTimeRfc3339("2021-10-14T16:00:00+02:00"),
+			expectedRemaining: calendar.HourToDuration(12),
+		},
+		{
+			name:              "Exceeded",
+			at:                parseTimeRfc3339("2021-10-18T13:00:00+02:00"),
+			expectedRemaining: calendar.HourToDuration(-1),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			remaining, err := calendarTest.RemainingWorkingDuration(*timeline, 16, testCase.at)
+
+			s.Require().NoError(err)
+			s.Assert().Equal(testCase.expectedRemaining, remaining)
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestTimelineEvents() {
+	timeline := calendar.NewTimeline(parseTimeRfc3339("2021-10-13T10:00:00+02:00"))
+
+	s.Assert().ErrorIs(timeline.Resume(parseTimeRfc3339("2021-10-13T11:00:00+02:00")), calendar.ErrInvalidTimeline)
+	s.Assert().ErrorIs(timeline.Pause(parseTimeRfc3339("2021-10-13T09:00:00+02:00")), calendar.ErrInvalidTimeline)
+
+	s.Require().NoError(timeline.Pause(parseTimeRfc3339("2021-10-13T11:00:00+02:00")))
+	s.Assert().True(timeline.IsPaused())
+	s.Assert().ErrorIs(timeline.Pause(parseTimeRfc3339("2021-10-13T12:00:00+02:00")), calendar.ErrInvalidTimeline)
+	s.Assert().ErrorIs(timeline.Resume(parseTimeRfc3339("2021-10-13T10:30:00+02:00")), calendar.ErrInvalidTimeline)
+
+	s.Require().NoError(timeline.Resume(parseTimeRfc3339("2021-10-13T12:00:00+02:00")))
+	s.Assert().False(timeline.IsPaused())
+	s.Assert().Equal([]calendar.Pause{{
+		PausedAt:  parseTimeRfc3339("2021-10-13T11:00:00+02:00"),
+		ResumedAt: parseTimeRfc3339("2021-10-13T12:00:00+02:00"),
+	}}, timeline.Pauses)
+}
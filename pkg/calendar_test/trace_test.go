@@ -0,0 +1,241 @@
+package calendar_test
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/hu"
+)
+
+func (s *CalendarTestSuite) TestCalculateDueDateTrace() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		submitAt               time.Time
+		turnaroundDurationHour float64
+
+		expectedTrace calendar.Trace
+	}{
+		{
+			name:                   "Workday and segments",
+			submitAt:               parseTimeRfc3339("2021-10-22T14:00:00+02:00"),
+			turnaroundDurationHour: 12,
+			expectedTrace: calendar.Trace{
+				SubmitAt:   parseTimeRfc3339("2021-10-22T14:00:00+02:00"),
+				Turnaround: 12 * time.Hour,
+				DueAt:      parseTimeRfc3339("2021-10-26T10:00:00+02:00"),
+				Steps: []calendar.TraceStep{
+					{
+						Kind:      calendar.TraceWorkday,
+						Date:      calendar.NewDate(2021, time.October, 22),
+						Start:     parseTimeRfc3339("2021-10-22T14:00:00+02:00"),
+						End:       parseTimeRfc3339("2021-10-25T14:00:00+02:00"),
+						Consumed:  8 * time.Hour,
+						Remaining: 4 * time.Hour,
+						Note:      "",
+					},
+					{
+						Kind:      calendar.TraceSkip,
+						Date:      calendar.NewDate(2021, time.October, 23),
+						Start:     parseTimeRfc3339("2021-10-23T00:00:00+02:00"),
+						End:       parseTimeRfc3339("2021-10-24T00:00:00+02:00"),
+						Consumed:  0,
+						Remaining: 4 * time.Hour,
+						Note:      "Saturday",
+					},
+					{
+						Kind:      calendar.TraceSkip,
+						Date:      calendar.NewDate(2021, time.October, 24),
+						Start:     parseTimeRfc3339("2021-10-24T00:00:00+02:00"),
+						End:       parseTimeRfc3339("2021-10-25T00:00:00+02:00"),
+						Consumed:  0,
+						Remaining: 4 * time.Hour,
+						Note:      "Sunday",
+					},
+					{
+						Kind:      calendar.TraceSegment,
+						Date:      calendar.NewDate(2021, time.October, 25),
+						Start:     parseTimeRfc3339("2021-10-25T14:00:00+02:00"),
+						End:       parseTimeRfc3339("2021-10-25T17:00:00+02:00"),
+						Consumed:  3 * time.Hour,
+						Remaining: time.Hour,
+						Note:      "",
+					},
+					{
+						Kind:      calendar.TraceSegment,
+						Date:      calendar.NewDate(2021, time.October, 26),
+						Start:     parseTimeRfc3339("2021-10-26T09:00:00+02:00"),
+						End:       parseTimeRfc3339("2021-10-26T10:00:00+02:00"),
+						Consumed:  time.Hour,
+						Remaining: 0,
+						Note:      "",
+					},
+				},
+			},
+		},
+		{
+			name:                   "Holiday",
+			submitAt:               parseTimeRfc3339("2021-10-29T16:00:00+02:00"),
+			turnaroundDurationHour: 2,
+			expectedTrace: calendar.Trace{
+				SubmitAt:   parseTimeRfc3339("2021-10-29T16:00:00+02:00"),
+				Turnaround: 2 * time.Hour,
+				DueAt:      parseTimeRfc3339("2021-11-02T10:00:00+02:00"),
+				Steps: []calendar.TraceStep{
+					{
+						Kind:      calendar.TraceSegment,
+						Date:      calendar.NewDate(2021, time.October, 29),
+						Start:     parseTimeRfc3339("2021-10-29T16:00:00+02:00"),
+						End:       parseTimeRfc3339("2021-10-29T17:00:00+02:00"),
+						Consumed:  time.Hour,
+						Remaining: time.Hour,
+						Note:      "",
+					},
+					{
+						Kind:      calendar.TraceSkip,
+						Date:      calendar.NewDate(2021, time.October, 30),
+						Start:     parseTimeRfc3339("2021-10-30T00:00:00+02:00"),
+						End:       parseTimeRfc3339("2021-10-31T00:00:00+02:00"),
+						Consumed:  0,
+						Remaining: time.Hour,
+						Note:      "Saturday",
+					},
+					{
+						Kind:      calendar.TraceSkip,
+						Date:      calendar.NewDate(2021, time.October, 31),
+						Start:     parseTimeRfc3339("2021-10-31T00:00:00+02:00"),
+						End:       parseTimeRfc3339("2021-11-01T00:00:00+02:00"),
+						Consumed:  0,
+						Remaining: time.Hour,
+						Note:      "Sunday",
+					},
+					{
+						Kind:      calendar.TraceSkip,
+						Date:      calendar.NewDate(2021, time.November, 1),
+						Start:     parseTimeRfc3339("2021-11-01T00:00:00+02:00"),
+						End:       parseTimeRfc3339("2021-11-02T00:00:00+02:00"),
+						Consumed:  0,
+						Remaining: time.Hour,
+						Note:      "Mindenszentek",
+					},
+					{
+						Kind:      calendar.TraceSegment,
+						Date:      calendar.NewDate(2021, time.November, 2),
+						Start:     parseTimeRfc3339("2021-11-02T09:00:00+02:00"),
+						End:       parseTimeRfc3339("2021-11-02T10:00:00+02:00"),
+						Consumed:  time.Hour,
+						Remaining: 0,
+						Note:      "",
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			trace, err := calendarTest.CalculateDueDateTrace(testCase.submitAt, testCase.turnaroundDurationHour)
+
+			s.Require().NoError(err)
+			s.Assert().Equal(testCase.expectedTrace, trace)
+		})
+	}
+}
+
+func (s *CalendarTestSuite) TestCalculateDueDateTraceLocale() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+		Overrides: []calendar.DayOverride{
+			{Date: calendar.NewDate(2021, time.October, 27), Working: false},
+		},
+	})
+	s.Require().NoError(err)
+
+	expectedNotes := map[calendar.Locale][]string{
+		calendar.LocaleEnglish: {
+			"2 weeks", "Saturday", "Sunday", "Saturday", "Sunday", "override", "Saturday", "Sunday", "Mindenszentek",
+		},
+		calendar.LocaleHungarian: {
+			"2 hét", "szombat", "vasárnap", "szombat", "vasárnap", "munkanap-módosítás", "szombat", "vasárnap",
+			"Mindenszentek",
+		},
+		calendar.LocaleGerman: {
+			"2 Wochen", "Samstag", "Sonntag", "Samstag", "Sonntag", "Tagesänderung", "Samstag", "Sonntag",
+			"Mindenszentek",
+		},
+	}
+
+	for locale, expected := range expectedNotes {
+		trace, err := calendarTest.CalculateDueDateTraceLocale(parseTimeRfc3339("2021-10-11T16:00:00+02:00"), 106, locale)
+		s.Require().NoError(err)
+
+		notes := []string{}
+		for _, step := range trace.Steps {
+			if step.Note != "" {
+				notes = append(notes, step.Note)
+			}
+		}
+
+		s.Assert().Equal(expected, notes, locale)
+	}
+}
+
+func (s *CalendarTestSuite) TestCalculateDueDateTraceConsistent() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       hu.Holidays(),
+	})
+	s.Require().NoError(err)
+
+	random := rand.New(rand.NewSource(1)) //nolint:gosec // deterministic test data
+	submitAt := parseTimeRfc3339("2021-10-13T09:30:00+02:00")
+
+	for n := 0; n < 100; n++ {
+		turnaroundDurationHour := float64(random.Intn(2000)) / 4
+
+		dueAt, err := calendarTest.CalculateDueDate(submitAt, turnaroundDurationHour)
+		s.Require().NoError(err)
+
+		trace, err := calendarTest.CalculateDueDateTrace(submitAt, turnaroundDurationHour)
+		s.Require().NoError(err)
+		s.Require().Equal(dueAt, trace.DueAt)
+
+		consumed := time.Duration(0)
+		for _, step := range trace.Steps {
+			consumed += step.Consumed
+			s.Require().Equal(trace.Turnaround-consumed, step.Remaining)
+		}
+
+		s.Require().Equal(trace.Turnaround, consumed, "turnaround: %f", turnaroundDurationHour)
+	}
+
+	_, err = calendarTest.CalculateDueDateTrace(parseTimeRfc3339("2021-10-23T10:00:00+02:00"), 1)
+	s.Assert().ErrorIs(err, calendar.ErrInvalidSubmitTime)
+
+	for _, turnaroundDurationHour := range []float64{-3, math.NaN(), math.Inf(1), 1e300} {
+		_, err = calendarTest.CalculateDueDateTrace(submitAt, turnaroundDurationHour)
+		s.Assert().ErrorIs(err, calendar.ErrInvalidTurnaround, "turnaround: %f", turnaroundDurationHour)
+	}
+}
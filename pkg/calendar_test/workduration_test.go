@@ -0,0 +1,97 @@
+package calendar_test
+
+import (
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+func (s *CalendarTestSuite) TestFormatWorkingDuration() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		duration time.Duration
+
+		expectedValue string
+	}{
+		{name: "Days and hours", duration: 19 * time.Hour, expectedValue: "2 working days 3h"},
+		{name: "One day", duration: 8 * time.Hour, expectedValue: "1 working day"},
+		{name: "Hours and minutes", duration: 150 * time.Minute, expectedValue: "2h 30m"},
+		{name: "Seconds", duration: 8*time.Hour + 1500*time.Millisecond, expectedValue: "1 working day 1.5s"},
+		{name: "Zero", duration: 0, expectedValue: "0h"},
+		{name: "Negative", duration: -9 * time.Hour, expectedValue: "-1 working day 1h"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			value := calendarTest.FormatWorkingDuration(testCase.duration)
+			s.Assert().Equal(testCase.expectedValue, value)
+
+			duration, err := calendarTest.ParseWorkingDuration(value)
+			s.Require().NoError(err)
+			s.Assert().Equal(testCase.duration, duration)
+		})
+	}
+
+	s.Assert().Equal("2 munkanap 3h", calendarTest.FormatWorkingDurationLocale(19*time.Hour, calendar.LocaleHungarian))
+	s.Assert().Equal("1 Arbeitstag 30m", calendarTest.FormatWorkingDurationLocale(510*time.Minute, calendar.LocaleGerman))
+	s.Assert().Equal("-2 working days", calendarTest.FormatWorkingDurationLocale(-16*time.Hour, calendar.Locale("fr")))
+}
+
+func (s *CalendarTestSuite) TestParseWorkingDuration() {
+	calendarTest, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     10 * time.Hour,
+		WorkEnds:       16 * time.Hour,
+		TimeFormat:     calendar.TimeFormatDefault,
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		value string
+
+		expectedDuration time.Duration
+		expectedErr      error
+	}{
+		{name: "Days of calendar", value: "2 working days 3h", expectedDuration: 15 * time.Hour, expectedErr: nil},
+		{name: "Without spaces", value: "1h30m", expectedDuration: 90 * time.Minute, expectedErr: nil},
+		{name: "Fraction", value: "1.5 working days", expectedDuration: 9 * time.Hour, expectedErr: nil},
+		{name: "Singular and plural", value: "1 working days", expectedDuration: 6 * time.Hour, expectedErr: nil},
+		{name: "Empty", value: "", expectedDuration: 0, expectedErr: calendar.ErrInvalidWorkingDuration},
+		{name: "Missing unit", value: "3", expectedDuration: 0, expectedErr: calendar.ErrInvalidWorkingDuration},
+		{name: "Unknown unit", value: "3 days", expectedDuration: 0, expectedErr: calendar.ErrInvalidWorkingDuration},
+		{name: "Order", value: "30m 1h", expectedDuration: 0, expectedErr: calendar.ErrInvalidWorkingDuration},
+		{name: "Missing amount", value: "h", expectedDuration: 0, expectedErr: calendar.ErrInvalidWorkingDuration},
+		{
+			name: "Too long", value: "1000000 working days",
+			expectedDuration: 0, expectedErr: calendar.ErrInvalidWorkingDuration,
+		},
+		{
+			name: "Too long sum", value: "400000 working days 1000000h",
+			expectedDuration: 0, expectedErr: calendar.ErrInvalidWorkingDuration,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			duration, err := calendarTest.ParseWorkingDuration(testCase.value)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+			s.Assert().Equal(testCase.expectedDuration, duration)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: calendar.proto
+
+package calendarpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CalendarServiceClient is the client API for CalendarService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CalendarServiceClient interface {
+	// CalculateDueDate returns InvalidArgument status for invalid requests and submit times,
+	// NotFound status for unknown calendars.
+	CalculateDueDate(ctx context.Context, in *DueDateRequest, opts ...grpc.CallOption) (*DueDateResponse, error)
+	// CalculateDueDates calculates a stream of due dates.
+	// Errors are returned per item in DueDateResponse.error, the stream is not interrupted by them.
+	CalculateDueDates(ctx context.Context, opts ...grpc.CallOption) (CalendarService_CalculateDueDatesClient, error)
+	WorkingDuration(ctx context.Context, in *WorkingDurationRequest, opts ...grpc.CallOption) (*WorkingDurationResponse, error)
+	IsWorkingTime(ctx context.Context, in *IsWorkingTimeRequest, opts ...grpc.CallOption) (*IsWorkingTimeResponse, error)
+}
+
+type calendarServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCalendarServiceClient(cc grpc.ClientConnInterface) CalendarServiceClient {
+	return &calendarServiceClient{cc}
+}
+
+func (c *calendarServiceClient) CalculateDueDate(ctx context.Context, in *DueDateRequest, opts ...grpc.CallOption) (*DueDateResponse, error) {
+	out := new(DueDateResponse)
+	err := c.cc.Invoke(ctx, "/datecalculator.calendar.v1.CalendarService/CalculateDueDate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) CalculateDueDates(ctx context.Context, opts ...grpc.CallOption) (CalendarService_CalculateDueDatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalendarService_ServiceDesc.Streams[0], "/datecalculator.calendar.v1.CalendarService/CalculateDueDates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calendarServiceCalculateDueDatesClient{stream}
+	return x, nil
+}
+
+type CalendarService_CalculateDueDatesClient interface {
+	Send(*DueDateRequest) error
+	Recv() (*DueDateResponse, error)
+	grpc.ClientStream
+}
+
+type calendarServiceCalculateDueDatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *calendarServiceCalculateDueDatesClient) Send(m *DueDateRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calendarServiceCalculateDueDatesClient) Recv() (*DueDateResponse, error) {
+	m := new(DueDateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calendarServiceClient) WorkingDuration(ctx context.Context, in *WorkingDurationRequest, opts ...grpc.CallOption) (*WorkingDurationResponse, error) {
+	out := new(WorkingDurationResponse)
+	err := c.cc.Invoke(ctx, "/datecalculator.calendar.v1.CalendarService/WorkingDuration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) IsWorkingTime(ctx context.Context, in *IsWorkingTimeRequest, opts ...grpc.CallOption) (*IsWorkingTimeResponse, error) {
+	out := new(IsWorkingTimeResponse)
+	err := c.cc.Invoke(ctx, "/datecalculator.calendar.v1.CalendarService/IsWorkingTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CalendarServiceServer is the server API for CalendarService service.
+// All implementations must embed UnimplementedCalendarServiceServer
+// for forward compatibility
+type CalendarServiceServer interface {
+	// CalculateDueDate returns InvalidArgument status for invalid requests and submit times,
+	// NotFound status for unknown calendars.
+	CalculateDueDate(context.Context, *DueDateRequest) (*DueDateResponse, error)
+	// CalculateDueDates calculates a stream of due dates.
+	// Errors are returned per item in DueDateResponse.error, the stream is not interrupted by them.
+	CalculateDueDates(CalendarService_CalculateDueDatesServer) error
+	WorkingDuration(context.Context, *WorkingDurationRequest) (*WorkingDurationResponse, error)
+	IsWorkingTime(context.Context, *IsWorkingTimeRequest) (*IsWorkingTimeResponse, error)
+	mustEmbedUnimplementedCalendarServiceServer()
+}
+
+// UnimplementedCalendarServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCalendarServiceServer struct {
+}
+
+func (UnimplementedCalendarServiceServer) CalculateDueDate(context.Context, *DueDateRequest) (*DueDateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateDueDate not implemented")
+}
+func (UnimplementedCalendarServiceServer) CalculateDueDates(CalendarService_CalculateDueDatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method CalculateDueDates not implemented")
+}
+func (UnimplementedCalendarServiceServer) WorkingDuration(context.Context, *WorkingDurationRequest) (*WorkingDurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkingDuration not implemented")
+}
+func (UnimplementedCalendarServiceServer) IsWorkingTime(context.Context, *IsWorkingTimeRequest) (*IsWorkingTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsWorkingTime not implemented")
+}
+func (UnimplementedCalendarServiceServer) mustEmbedUnimplementedCalendarServiceServer() {}
+
+// UnsafeCalendarServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalendarServiceServer will
+// result in compilation errors.
+type UnsafeCalendarServiceServer interface {
+	mustEmbedUnimplementedCalendarServiceServer()
+}
+
+func RegisterCalendarServiceServer(s grpc.ServiceRegistrar, srv CalendarServiceServer) {
+	s.RegisterService(&CalendarService_ServiceDesc, srv)
+}
+
+func _CalendarService_CalculateDueDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DueDateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).CalculateDueDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datecalculator.calendar.v1.CalendarService/CalculateDueDate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).CalculateDueDate(ctx, req.(*DueDateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_CalculateDueDates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalendarServiceServer).CalculateDueDates(&calendarServiceCalculateDueDatesServer{stream})
+}
+
+type CalendarService_CalculateDueDatesServer interface {
+	Send(*DueDateResponse) error
+	Recv() (*DueDateRequest, error)
+	grpc.ServerStream
+}
+
+type calendarServiceCalculateDueDatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *calendarServiceCalculateDueDatesServer) Send(m *DueDateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calendarServiceCalculateDueDatesServer) Recv() (*DueDateRequest, error) {
+	m := new(DueDateRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalendarService_WorkingDuration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkingDurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).WorkingDuration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datecalculator.calendar.v1.CalendarService/WorkingDuration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).WorkingDuration(ctx, req.(*WorkingDurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_IsWorkingTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsWorkingTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).IsWorkingTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datecalculator.calendar.v1.CalendarService/IsWorkingTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).IsWorkingTime(ctx, req.(*IsWorkingTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CalendarService_ServiceDesc is the grpc.ServiceDesc for CalendarService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CalendarService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "datecalculator.calendar.v1.CalendarService",
+	HandlerType: (*CalendarServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CalculateDueDate",
+			Handler:    _CalendarService_CalculateDueDate_Handler,
+		},
+		{
+			MethodName: "WorkingDuration",
+			Handler:    _CalendarService_WorkingDuration_Handler,
+		},
+		{
+			MethodName: "IsWorkingTime",
+			Handler:    _CalendarService_IsWorkingTime_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CalculateDueDates",
+			Handler:       _CalendarService_CalculateDueDates_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "calendar.proto",
+}
@@ -0,0 +1,201 @@
+// Package grpcserver implements the CalendarService gRPC API, see calendarpb.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendarpb"
+	"github.com/pgillich/date_calculator/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrInvalidRequest = errors.New("invalid request")
+
+type Server struct {
+	calendarpb.UnimplementedCalendarServiceServer
+
+	registry *registry.Registry
+}
+
+func New(calendars *registry.Registry) *Server {
+	return &Server{
+		UnimplementedCalendarServiceServer: calendarpb.UnimplementedCalendarServiceServer{},
+		registry:                           calendars,
+	}
+}
+
+func (server *Server) CalculateDueDate(
+	_ context.Context, request *calendarpb.DueDateRequest,
+) (*calendarpb.DueDateResponse, error) {
+	response := server.calculateDueDate(request)
+	if response.Error != nil {
+		return nil, status.Error(statusCode(response.Error.Code), response.Error.Message)
+	}
+
+	return response, nil
+}
+
+func (server *Server) CalculateDueDates(stream calendarpb.CalendarService_CalculateDueDatesServer) error {
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err //nolint:wrapcheck // status error of the stream
+		}
+
+		if err := stream.Send(server.calculateDueDate(request)); err != nil {
+			return err //nolint:wrapcheck // status error of the stream
+		}
+	}
+}
+
+func (server *Server) WorkingDuration(
+	_ context.Context, request *calendarpb.WorkingDurationRequest,
+) (*calendarpb.WorkingDurationResponse, error) {
+	calendarNamed, err := server.registry.Calendar(request.Calendar)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	from, err := parseTime(calendarNamed, "from", request.From)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	to, err := parseTime(calendarNamed, "to", request.To)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	workingDuration := calendarNamed.WorkingDurationBetween(from, to)
+	if err := calendar.ValidateWorkingDuration(workingDuration); err != nil {
+		return nil, statusError(fmt.Errorf("%w: %s", ErrInvalidRequest, err.Error()))
+	}
+
+	return &calendarpb.WorkingDurationResponse{
+		Calendar:        calendarName(request.Calendar),
+		WorkingHours:    workingDuration.Hours(),
+		WorkingDuration: workingDuration.String(),
+	}, nil
+}
+
+func (server *Server) IsWorkingTime(
+	_ context.Context, request *calendarpb.IsWorkingTimeRequest,
+) (*calendarpb.IsWorkingTimeResponse, error) {
+	calendarNamed, err := server.registry.Calendar(request.Calendar)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	at, err := parseTime(calendarNamed, "at", request.At)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &calendarpb.IsWorkingTimeResponse{
+		Calendar:    calendarName(request.Calendar),
+		WorkingTime: calendarNamed.IsWorkingTime(at),
+	}, nil
+}
+
+func (server *Server) calculateDueDate(request *calendarpb.DueDateRequest) *calendarpb.DueDateResponse {
+	response := &calendarpb.DueDateResponse{
+		Id:       request.Id,
+		Calendar: calendarName(request.Calendar),
+	}
+
+	if err := calendar.ValidateTurnaroundHours(request.TurnaroundHours); err != nil {
+		response.Error = responseError(fmt.Errorf("%w: turnaround_hours, %s", ErrInvalidRequest, err.Error()))
+
+		return response
+	}
+
+	calendarNamed, err := server.registry.Calendar(request.Calendar)
+	if err != nil {
+		response.Error = responseError(err)
+
+		return response
+	}
+
+	submitAt, err := parseTime(calendarNamed, "submit_at", request.SubmitAt)
+	if err != nil {
+		response.Error = responseError(err)
+
+		return response
+	}
+
+	dueAt, err := calendarNamed.CalculateDueDate(submitAt, request.TurnaroundHours)
+	if err != nil {
+		response.Error = responseError(err)
+
+		return response
+	}
+
+	response.DueAt = calendarNamed.FormatTime(dueAt)
+
+	return response
+}
+
+func calendarName(name string) string {
+	if name == "" {
+		return registry.DefaultName
+	}
+
+	return name
+}
+
+// parseTime parses the value of the field by the time format of the calendar.
+func parseTime(calendarNamed *calendar.Calendar, field string, value string) (time.Time, error) {
+	parsed, err := calendarNamed.ParseTime(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s, %s", ErrInvalidRequest, field, err.Error())
+	}
+
+	return parsed, nil
+}
+
+func errorCode(err error) calendarpb.ErrorCode {
+	switch {
+	case errors.Is(err, ErrInvalidRequest):
+		return calendarpb.ErrorCode_ERROR_CODE_INVALID_REQUEST
+	case errors.Is(err, registry.ErrUnknownCalendar):
+		return calendarpb.ErrorCode_ERROR_CODE_UNKNOWN_CALENDAR
+	case errors.Is(err, calendar.ErrInvalidSubmitTime):
+		return calendarpb.ErrorCode_ERROR_CODE_INVALID_SUBMIT_TIME
+	default:
+		return calendarpb.ErrorCode_ERROR_CODE_INTERNAL
+	}
+}
+
+func statusCode(code calendarpb.ErrorCode) codes.Code {
+	switch code {
+	case calendarpb.ErrorCode_ERROR_CODE_INVALID_REQUEST, calendarpb.ErrorCode_ERROR_CODE_INVALID_SUBMIT_TIME:
+		return codes.InvalidArgument
+	case calendarpb.ErrorCode_ERROR_CODE_UNKNOWN_CALENDAR:
+		return codes.NotFound
+	case calendarpb.ErrorCode_ERROR_CODE_UNSPECIFIED, calendarpb.ErrorCode_ERROR_CODE_INTERNAL:
+		return codes.Internal
+	default:
+		return codes.Internal
+	}
+}
+
+func responseError(err error) *calendarpb.Error {
+	return &calendarpb.Error{
+		Code:    errorCode(err),
+		Message: err.Error(),
+	}
+}
+
+func statusError(err error) error {
+	return status.Error(statusCode(errorCode(err)), err.Error()) //nolint:wrapcheck // status error
+}
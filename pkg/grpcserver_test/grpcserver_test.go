@@ -0,0 +1,233 @@
+package grpcserver_test
+
+import (
+	"context"
+	"io"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendarpb"
+	"github.com/pgillich/date_calculator/pkg/grpcserver"
+	"github.com/pgillich/date_calculator/pkg/registry"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+type GrpcServerTestSuite struct {
+	suite.Suite
+
+	server     *grpc.Server
+	connection *grpc.ClientConn
+	client     calendarpb.CalendarServiceClient
+}
+
+func TestGrpcServerTestSuite(t *testing.T) {
+	suite.Run(t, new(GrpcServerTestSuite))
+}
+
+func (s *GrpcServerTestSuite) SetupSuite() {
+	calendars, err := registry.Builtin()
+	s.Require().NoError(err)
+
+	calendarMinutes, err := calendar.NewCalendar(calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     "2006-01-02 15:04",
+		Location:       time.UTC,
+	})
+	s.Require().NoError(err)
+	calendars.Register("minutes", calendarMinutes)
+
+	listener := bufconn.Listen(bufSize)
+	s.server = grpc.NewServer()
+	calendarpb.RegisterCalendarServiceServer(s.server, grpcserver.New(calendars))
+
+	go func() {
+		_ = s.server.Serve(listener)
+	}()
+
+	s.connection, err = grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	s.Require().NoError(err)
+
+	s.client = calendarpb.NewCalendarServiceClient(s.connection)
+}
+
+func (s *GrpcServerTestSuite) TearDownSuite() {
+	s.connection.Close()
+	s.server.Stop()
+}
+
+func (s *GrpcServerTestSuite) TestCalculateDueDate() {
+	testCases := []struct {
+		name string
+
+		request *calendarpb.DueDateRequest
+
+		expectedDueAt string
+		expectedCode  codes.Code
+	}{
+		{
+			name: "Resolved",
+			request: &calendarpb.DueDateRequest{
+				Calendar: "hu", SubmitAt: "2021-10-29T16:00:00+02:00", TurnaroundHours: 2,
+			},
+			expectedDueAt: "2021-11-02T10:00:00+02:00",
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "Invalid submit time",
+			request: &calendarpb.DueDateRequest{
+				Calendar: "hu", SubmitAt: "2021-11-01T10:00:00+01:00", TurnaroundHours: 2,
+			},
+			expectedDueAt: "",
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Invalid time",
+			request: &calendarpb.DueDateRequest{
+				Calendar: "hu", SubmitAt: "2021-11-01 10:00", TurnaroundHours: 2,
+			},
+			expectedDueAt: "",
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Time format of the calendar",
+			request: &calendarpb.DueDateRequest{
+				Calendar: "minutes", SubmitAt: "2021-10-29 16:00", TurnaroundHours: 2,
+			},
+			expectedDueAt: "2021-11-01 10:00",
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "Negative turnaround",
+			request: &calendarpb.DueDateRequest{
+				Calendar: "hu", SubmitAt: "2021-10-29T16:00:00+02:00", TurnaroundHours: -2,
+			},
+			expectedDueAt: "",
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Too long turnaround",
+			request: &calendarpb.DueDateRequest{
+				Calendar: "hu", SubmitAt: "2021-10-29T16:00:00+02:00", TurnaroundHours: 1e300,
+			},
+			expectedDueAt: "",
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Infinite turnaround",
+			request: &calendarpb.DueDateRequest{
+				Calendar: "hu", SubmitAt: "2021-10-29T16:00:00+02:00", TurnaroundHours: math.Inf(1),
+			},
+			expectedDueAt: "",
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Not a number turnaround",
+			request: &calendarpb.DueDateRequest{
+				Calendar: "hu", SubmitAt: "2021-10-29T16:00:00+02:00", TurnaroundHours: math.NaN(),
+			},
+			expectedDueAt: "",
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Unknown calendar",
+			request: &calendarpb.DueDateRequest{
+				Calendar: "xx", SubmitAt: "2021-10-29T16:00:00+02:00", TurnaroundHours: 2,
+			},
+			expectedDueAt: "",
+			expectedCode:  codes.NotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			response, err := s.client.CalculateDueDate(context.Background(), testCase.request)
+
+			s.Assert().Equal(testCase.expectedCode, status.Code(err))
+			s.Assert().Equal(testCase.expectedDueAt, response.GetDueAt())
+		})
+	}
+}
+
+func (s *GrpcServerTestSuite) TestCalculateDueDates() {
+	requests := []*calendarpb.DueDateRequest{
+		{Id: "1", Calendar: "hu", SubmitAt: "2021-10-29T16:00:00+02:00", TurnaroundHours: 2},
+		{Id: "2", Calendar: "hu", SubmitAt: "2021-11-01T10:00:00+01:00", TurnaroundHours: 2},
+		{Id: "3", Calendar: "", SubmitAt: "2021-10-29T16:00:00+02:00", TurnaroundHours: 2},
+	}
+
+	expectedResponses := []struct {
+		id        string
+		calendar  string
+		dueAt     string
+		errorCode calendarpb.ErrorCode
+	}{
+		{id: "1", calendar: "hu", dueAt: "2021-11-02T10:00:00+02:00", errorCode: calendarpb.ErrorCode_ERROR_CODE_UNSPECIFIED},
+		{id: "2", calendar: "hu", dueAt: "", errorCode: calendarpb.ErrorCode_ERROR_CODE_INVALID_SUBMIT_TIME},
+		{id: "3", calendar: "default", dueAt: "2021-11-01T10:00:00+02:00", errorCode: calendarpb.ErrorCode_ERROR_CODE_UNSPECIFIED},
+	}
+
+	stream, err := s.client.CalculateDueDates(context.Background())
+	s.Require().NoError(err)
+
+	for _, request := range requests {
+		s.Require().NoError(stream.Send(request))
+	}
+
+	s.Require().NoError(stream.CloseSend())
+
+	for _, expected := range expectedResponses {
+		response, err := stream.Recv()
+		s.Require().NoError(err)
+
+		s.Assert().Equal(expected.id, response.GetId())
+		s.Assert().Equal(expected.calendar, response.GetCalendar())
+		s.Assert().Equal(expected.dueAt, response.GetDueAt())
+		s.Assert().Equal(expected.errorCode, response.GetError().GetCode())
+	}
+
+	_, err = stream.Recv()
+	s.Assert().ErrorIs(err, io.EOF)
+}
+
+func (s *GrpcServerTestSuite) TestWorkingDuration() {
+	response, err := s.client.WorkingDuration(context.Background(), &calendarpb.WorkingDurationRequest{
+		Calendar: "hu", From: "2021-10-29T16:00:00+02:00", To: "2021-11-02T10:30:00+02:00",
+	})
+	s.Require().NoError(err)
+
+	s.Assert().Equal(2.5, response.GetWorkingHours())
+	s.Assert().Equal("2h30m0s", response.GetWorkingDuration())
+
+	_, err = s.client.WorkingDuration(context.Background(), &calendarpb.WorkingDurationRequest{
+		Calendar: "hu", From: "0001-01-01T00:00:00Z", To: "9999-12-31T00:00:00Z",
+	})
+	s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *GrpcServerTestSuite) TestIsWorkingTime() {
+	response, err := s.client.IsWorkingTime(context.Background(), &calendarpb.IsWorkingTimeRequest{
+		Calendar: "us", At: "2021-07-05T10:00:00-04:00",
+	})
+	s.Require().NoError(err)
+
+	s.Assert().False(response.GetWorkingTime())
+}
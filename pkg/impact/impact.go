@@ -0,0 +1,174 @@
+// Package impact compares the due dates of tickets by two calendars, for example before changing the working hours.
+package impact
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+var ErrInvalidTicket = errors.New("invalid ticket")
+
+// Ticket is an open ticket with its submit time and turnaround.
+type Ticket struct {
+	ID              string    `json:"id,omitempty"`
+	SubmitAt        time.Time `json:"submitAt"`
+	TurnaroundHours float64   `json:"turnaroundHours"`
+}
+
+// Change is a ticket having different due date by the new calendar.
+// Shift is the difference of the due dates, WorkingShift is the working time between them by the new calendar,
+// both are negative, if the new due date is earlier. If the due date can't be calculated by a calendar
+// (for example the submit time is off the new working hours), its due date is zero and the error is set.
+type Change struct {
+	Ticket
+	OldDueAt     time.Time     `json:"oldDueAt"`
+	NewDueAt     time.Time     `json:"newDueAt"`
+	OldError     string        `json:"oldError,omitempty"`
+	NewError     string        `json:"newError,omitempty"`
+	Shift        time.Duration `json:"shift"`
+	WorkingShift time.Duration `json:"workingShift"`
+}
+
+// Report lists the changed tickets in the order of the tickets.
+type Report struct {
+	Tickets int      `json:"tickets"`
+	Changes []Change `json:"changes"`
+}
+
+// Analyze calculates the due dates of the tickets by both calendars and returns the changed ones.
+func Analyze(oldCalendar, newCalendar *calendar.Calendar, tickets []Ticket) Report {
+	requests := make([]calendar.DueDateRequest, 0, len(tickets))
+	for _, ticket := range tickets {
+		requests = append(requests, calendar.DueDateRequest{
+			SubmitAt:               ticket.SubmitAt,
+			TurnaroundDurationHour: ticket.TurnaroundHours,
+		})
+	}
+
+	oldResults := oldCalendar.CalculateDueDates(requests, 1)
+	newResults := newCalendar.CalculateDueDates(requests, 1)
+	report := Report{Tickets: len(tickets), Changes: []Change{}}
+
+	for t, ticket := range tickets {
+		oldResult, newResult := oldResults[t], newResults[t]
+
+		if oldResult.Err == nil && newResult.Err == nil && oldResult.ResolvedAt.Equal(newResult.ResolvedAt) ||
+			oldResult.Err != nil && newResult.Err != nil {
+			continue
+		}
+
+		change := Change{
+			Ticket:       ticket,
+			OldDueAt:     oldResult.ResolvedAt,
+			NewDueAt:     newResult.ResolvedAt,
+			OldError:     errorMessage(oldResult.Err),
+			NewError:     errorMessage(newResult.Err),
+			Shift:        0,
+			WorkingShift: 0,
+		}
+
+		if oldResult.Err == nil && newResult.Err == nil {
+			change.Shift = change.NewDueAt.Sub(change.OldDueAt)
+
+			if change.Shift > 0 {
+				change.WorkingShift = newCalendar.WorkingDurationBetween(change.OldDueAt, change.NewDueAt)
+			} else {
+				change.WorkingShift = -newCalendar.WorkingDurationBetween(change.NewDueAt, change.OldDueAt)
+			}
+		}
+
+		report.Changes = append(report.Changes, change)
+	}
+
+	return report
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
+
+// ReadTickets reads the tickets in JSON (array of Ticket) or in CSV format, see ReadTicketsCSV.
+func ReadTickets(reader io.Reader) ([]Ticket, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read tickets: %w", err)
+	}
+
+	if trimmed := bytes.TrimSpace(content); len(trimmed) > 0 && trimmed[0] == '[' {
+		return ReadTicketsJSON(bytes.NewReader(content))
+	}
+
+	return ReadTicketsCSV(bytes.NewReader(content))
+}
+
+func ReadTicketsJSON(reader io.Reader) ([]Ticket, error) {
+	tickets := []Ticket{}
+
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&tickets); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTicket, err.Error())
+	}
+
+	return tickets, nil
+}
+
+// ReadTicketsCSV reads the tickets in CSV format, with header. The columns are submitAt (RFC 3339),
+// turnaroundHours and the optional id, in any order.
+func ReadTicketsCSV(reader io.Reader) ([]Ticket, error) {
+	records, err := csv.NewReader(reader).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTicket, err.Error())
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidTicket)
+	}
+
+	columns := map[string]int{}
+	for c, name := range records[0] {
+		columns[name] = c
+	}
+
+	for _, name := range []string{"submitAt", "turnaroundHours"} {
+		if _, has := columns[name]; !has {
+			return nil, fmt.Errorf("%w: missing column %s", ErrInvalidTicket, name)
+		}
+	}
+
+	tickets := make([]Ticket, 0, len(records)-1)
+
+	for r, record := range records[1:] {
+		line := r + 2 //nolint:gomnd // after the header, 1-based
+		ticket := Ticket{ID: "", SubmitAt: time.Time{}, TurnaroundHours: 0}
+
+		if c, has := columns["id"]; has {
+			ticket.ID = record[c]
+		}
+
+		if ticket.SubmitAt, err = time.Parse(time.RFC3339, record[columns["submitAt"]]); err != nil {
+			return nil, fmt.Errorf("%w: line %d, %s", ErrInvalidTicket, line, err.Error())
+		}
+
+		if ticket.TurnaroundHours, err = strconv.ParseFloat(record[columns["turnaroundHours"]], 64); err != nil {
+			return nil, fmt.Errorf("%w: line %d, %s", ErrInvalidTicket, line, err.Error())
+		}
+
+		tickets = append(tickets, ticket)
+	}
+
+	return tickets, nil
+}
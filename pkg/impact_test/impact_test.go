@@ -0,0 +1,147 @@
+package impact_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/impact"
+	"github.com/pgillich/date_calculator/pkg/registry"
+)
+
+type ImpactTestSuite struct {
+	suite.Suite
+}
+
+func TestImpactTestSuite(t *testing.T) {
+	suite.Run(t, new(ImpactTestSuite))
+}
+
+func parseTimeRfc3339(value string) time.Time {
+	parsed, _ := time.Parse(time.RFC3339, value)
+
+	return parsed
+}
+
+func (s *ImpactTestSuite) loadCalendar(config string) *calendar.Calendar {
+	calendarConfig, err := registry.LoadConfig(strings.NewReader(config))
+	s.Require().NoError(err)
+
+	calendarLoaded, err := calendar.NewCalendar(calendarConfig)
+	s.Require().NoError(err)
+
+	return calendarLoaded
+}
+
+func (s *ImpactTestSuite) TestAnalyze() {
+	oldCalendar := s.loadCalendar(`{}`)
+	newCalendar := s.loadCalendar(`{"workEnds": "18:00", "holidays": [{"date": "2021-10-14", "name": "Bridge day"}]}`)
+
+	tickets, err := impact.ReadTickets(strings.NewReader(`id,submitAt,turnaroundHours
+A,2021-10-13T10:00:00+02:00,4
+B,2021-10-13T10:00:00+02:00,12
+C,2021-10-13T17:30:00+02:00,1
+D,2021-10-14T10:00:00+02:00,1
+E,2021-10-16T10:00:00+02:00,1
+`))
+	s.Require().NoError(err)
+
+	report := impact.Analyze(oldCalendar, newCalendar, tickets)
+
+	s.Assert().Equal(5, report.Tickets)
+	s.Require().Len(report.Changes, 3)
+
+	s.Assert().Equal("B", report.Changes[0].ID)
+	s.Assert().Equal(parseTimeRfc3339("2021-10-14T14:00:00+02:00"), report.Changes[0].OldDueAt)
+	s.Assert().Equal(parseTimeRfc3339("2021-10-15T13:00:00+02:00"), report.Changes[0].NewDueAt)
+	s.Assert().Equal(23*time.Hour, report.Changes[0].Shift)
+	s.Assert().Equal(4*time.Hour, report.Changes[0].WorkingShift)
+
+	s.Assert().Equal("C", report.Changes[1].ID)
+	s.Assert().True(report.Changes[1].OldDueAt.IsZero())
+	s.Assert().NotEmpty(report.Changes[1].OldError)
+	s.Assert().Equal(parseTimeRfc3339("2021-10-15T09:30:00+02:00"), report.Changes[1].NewDueAt)
+	s.Assert().Empty(report.Changes[1].NewError)
+
+	s.Assert().Equal("D", report.Changes[2].ID)
+	s.Assert().Equal(parseTimeRfc3339("2021-10-14T11:00:00+02:00"), report.Changes[2].OldDueAt)
+	s.Assert().Contains(report.Changes[2].NewError, "Bridge day")
+}
+
+func (s *ImpactTestSuite) TestAnalyzeEarlier() {
+	oldCalendar := s.loadCalendar(`{"workEnds": "18:00", "holidays": [{"date": "2021-10-14", "name": "Bridge day"}]}`)
+	newCalendar := s.loadCalendar(`{}`)
+
+	report := impact.Analyze(oldCalendar, newCalendar, []impact.Ticket{
+		{ID: "B", SubmitAt: parseTimeRfc3339("2021-10-13T10:00:00+02:00"), TurnaroundHours: 12},
+	})
+
+	s.Require().Len(report.Changes, 1)
+	s.Assert().Equal(-23*time.Hour, report.Changes[0].Shift)
+	s.Assert().Equal(-7*time.Hour, report.Changes[0].WorkingShift)
+}
+
+func (s *ImpactTestSuite) TestReadTickets() {
+	testCases := []struct {
+		name string
+
+		content string
+
+		expectedTickets []impact.Ticket
+		expectedErr     error
+	}{
+		{
+			name:    "JSON",
+			content: ` [{"id": "A", "submitAt": "2021-10-13T10:00:00+02:00", "turnaroundHours": 4}]`,
+			expectedTickets: []impact.Ticket{
+				{ID: "A", SubmitAt: parseTimeRfc3339("2021-10-13T10:00:00+02:00"), TurnaroundHours: 4},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:    "CSV without id",
+			content: "turnaroundHours,submitAt\n1.5,2021-10-13T10:00:00+02:00\n",
+			expectedTickets: []impact.Ticket{
+				{ID: "", SubmitAt: parseTimeRfc3339("2021-10-13T10:00:00+02:00"), TurnaroundHours: 1.5},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:            "Unknown JSON field",
+			content:         `[{"submitAt": "2021-10-13T10:00:00+02:00", "turnaround": 4}]`,
+			expectedTickets: nil,
+			expectedErr:     impact.ErrInvalidTicket,
+		},
+		{
+			name:            "Missing column",
+			content:         "id,submitAt\nA,2021-10-13T10:00:00+02:00\n",
+			expectedTickets: nil,
+			expectedErr:     impact.ErrInvalidTicket,
+		},
+		{
+			name:            "Invalid time",
+			content:         "submitAt,turnaroundHours\n2021-10-13 10:00,4\n",
+			expectedTickets: nil,
+			expectedErr:     impact.ErrInvalidTicket,
+		},
+		{
+			name:            "Empty",
+			content:         "",
+			expectedTickets: nil,
+			expectedErr:     impact.ErrInvalidTicket,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			tickets, err := impact.ReadTickets(strings.NewReader(testCase.content))
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+			s.Assert().Equal(testCase.expectedTickets, tickets)
+		})
+	}
+}
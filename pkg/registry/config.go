@@ -0,0 +1,209 @@
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+)
+
+var ErrInvalidConfig = errors.New("invalid calendar config")
+
+const minutesPerHour = 60
+
+/*
+ConfigFile is the JSON format of a calendar.Config. Empty fields have the default values.
+The times of the day are in HH:MM format, FirstWorkday is an English weekday name.
+HolidaySets are the names of the bundled holiday sets, see Builtin, for example "hu" or "de-by".
+Holidays are single dates (with working hours, if partial).
+*/
+type ConfigFile struct {
+	FirstWorkday   string         `json:"firstWorkday,omitempty"`
+	WorkdaysInWeek int            `json:"workdaysInWeek,omitempty"`
+	WorkBegins     string         `json:"workBegins,omitempty"`
+	WorkEnds       string         `json:"workEnds,omitempty"`
+	TimeFormat     string         `json:"timeFormat,omitempty"`
+	Location       string         `json:"location,omitempty"`
+	HolidaySets    []string       `json:"holidaySets,omitempty"`
+	Holidays       []HolidayFile  `json:"holidays,omitempty"`
+	Overrides      []OverrideFile `json:"overrides,omitempty"`
+}
+
+type HolidayFile struct {
+	Date       string `json:"date"`
+	Name       string `json:"name"`
+	WorkBegins string `json:"workBegins,omitempty"`
+	WorkEnds   string `json:"workEnds,omitempty"`
+}
+
+type OverrideFile struct {
+	Date       string `json:"date"`
+	Working    bool   `json:"working"`
+	WorkBegins string `json:"workBegins,omitempty"`
+	WorkEnds   string `json:"workEnds,omitempty"`
+}
+
+// LoadConfig reads a ConfigFile in JSON format.
+func LoadConfig(reader io.Reader) (calendar.Config, error) {
+	var file ConfigFile
+
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&file); err != nil {
+		return calendar.Config{}, fmt.Errorf("%w: %s", ErrInvalidConfig, err.Error())
+	}
+
+	return file.Config()
+}
+
+// Config converts the file to calendar.Config.
+func (file ConfigFile) Config() (calendar.Config, error) {
+	config := defaultConfig([]calendar.HolidayRule{})
+
+	if file.FirstWorkday != "" {
+		weekday, err := parseWeekday(file.FirstWorkday)
+		if err != nil {
+			return calendar.Config{}, err
+		}
+
+		config.FirstWorkday = weekday
+	}
+
+	if file.WorkdaysInWeek != 0 {
+		config.WorkdaysInWeek = file.WorkdaysInWeek
+	}
+
+	var err error
+
+	if config.WorkBegins, err = parseClock(file.WorkBegins, config.WorkBegins); err != nil {
+		return calendar.Config{}, err
+	}
+
+	if config.WorkEnds, err = parseClock(file.WorkEnds, config.WorkEnds); err != nil {
+		return calendar.Config{}, err
+	}
+
+	if file.TimeFormat != "" {
+		config.TimeFormat = file.TimeFormat
+	}
+
+	if file.Location != "" {
+		location, err := time.LoadLocation(file.Location)
+		if err != nil {
+			return calendar.Config{}, fmt.Errorf("%w: %s", ErrInvalidConfig, err.Error())
+		}
+
+		config.Location = location
+	}
+
+	sets := holidaySets()
+
+	for _, name := range file.HolidaySets {
+		holidays, has := sets[name]
+		if !has {
+			return calendar.Config{}, fmt.Errorf("%w: unknown holiday set %s", ErrInvalidConfig, name)
+		}
+
+		config.Holidays = append(config.Holidays, holidays...)
+	}
+
+	for _, holidayFile := range file.Holidays {
+		holiday, err := holidayFile.rule()
+		if err != nil {
+			return calendar.Config{}, err
+		}
+
+		config.Holidays = append(config.Holidays, holiday)
+	}
+
+	for _, overrideFile := range file.Overrides {
+		override, err := overrideFile.override()
+		if err != nil {
+			return calendar.Config{}, err
+		}
+
+		config.Overrides = append(config.Overrides, override)
+	}
+
+	return config, nil
+}
+
+// rule returns the holiday of the single date. The rules of the bundled sets win on the same date.
+func (holidayFile HolidayFile) rule() (calendar.HolidayRule, error) {
+	date, err := calendar.ParseDate(holidayFile.Date)
+	if err != nil {
+		return nil, fmt.Errorf("%w: holiday %s", ErrInvalidConfig, err.Error())
+	}
+
+	var rule calendar.HolidayRule = calendar.HolidayYears{
+		Rule: calendar.FixedHoliday{Name: holidayFile.Name, Month: date.Month, Day: date.Day},
+		From: date.Year,
+		To:   date.Year,
+	}
+
+	if holidayFile.WorkBegins == "" && holidayFile.WorkEnds == "" {
+		return rule, nil
+	}
+
+	partial := calendar.PartialHoliday{Rule: rule, WorkBegins: 0, WorkEnds: 0}
+
+	if partial.WorkBegins, err = parseClock(holidayFile.WorkBegins, 0); err != nil {
+		return nil, err
+	}
+
+	if partial.WorkEnds, err = parseClock(holidayFile.WorkEnds, 0); err != nil {
+		return nil, err
+	}
+
+	return partial, nil
+}
+
+func (overrideFile OverrideFile) override() (calendar.DayOverride, error) {
+	date, err := calendar.ParseDate(overrideFile.Date)
+	if err != nil {
+		return calendar.DayOverride{}, fmt.Errorf("%w: override %s", ErrInvalidConfig, err.Error())
+	}
+
+	override := calendar.DayOverride{Date: date, Working: overrideFile.Working, WorkBegins: 0, WorkEnds: 0}
+
+	if override.WorkBegins, err = parseClock(overrideFile.WorkBegins, 0); err != nil {
+		return calendar.DayOverride{}, err
+	}
+
+	if override.WorkEnds, err = parseClock(overrideFile.WorkEnds, 0); err != nil {
+		return calendar.DayOverride{}, err
+	}
+
+	return override, nil
+}
+
+func parseWeekday(name string) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(weekday.String(), name) {
+			return weekday, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: unknown weekday %s", ErrInvalidConfig, name)
+}
+
+// parseClock parses a time of the day in HH:MM format, or returns fallback, if the value is empty.
+func parseClock(value string, fallback time.Duration) (time.Duration, error) {
+	if value == "" {
+		return fallback, nil
+	}
+
+	var hour, minute int
+
+	if _, err := fmt.Sscanf(value, "%d:%d", &hour, &minute); err != nil ||
+		!strings.HasSuffix(value, fmt.Sprintf(":%02d", minute)) || minute < 0 || minute >= minutesPerHour || hour < 0 {
+		return 0, fmt.Errorf("%w: invalid clock %s", ErrInvalidConfig, value)
+	}
+
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, nil
+}
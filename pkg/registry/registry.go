@@ -0,0 +1,140 @@
+// Package registry holds named calendars, for example for services selecting the calendar by request.
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/de"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/hu"
+	"github.com/pgillich/date_calculator/pkg/calendar/holidays/us"
+)
+
+const (
+	DefaultName = "default"
+	// Name24x7 is the calendar of the always working services.
+	Name24x7 = "24x7"
+)
+
+var ErrUnknownCalendar = errors.New("unknown calendar")
+
+type Registry struct {
+	mutex     sync.RWMutex
+	calendars map[string]*calendar.Calendar
+}
+
+func New() *Registry {
+	return &Registry{
+		mutex:     sync.RWMutex{},
+		calendars: map[string]*calendar.Calendar{},
+	}
+}
+
+// Builtin returns a registry with the default calendar, the "24x7" calendar and the calendars of
+// the bundled holiday sets: "hu", "us", "de" and the German states, for example "de-by".
+func Builtin() (*Registry, error) {
+	configs := map[string]calendar.Config{
+		DefaultName: defaultConfig(nil),
+		Name24x7: {
+			FirstWorkday:   time.Sunday,
+			WorkdaysInWeek: 7, //nolint:gomnd // all days of the week
+			WorkBegins:     0,
+			WorkEnds:       24 * time.Hour, //nolint:gomnd // whole day
+			TimeFormat:     calendar.TimeFormatDefault,
+			Holidays:       nil,
+			Overrides:      nil,
+		},
+	}
+
+	for name, holidays := range holidaySets() {
+		configs[name] = defaultConfig(holidays)
+	}
+
+	registry := New()
+
+	for name, config := range configs {
+		calendarBuiltin, err := calendar.NewCalendar(config)
+		if err != nil {
+			return nil, fmt.Errorf("unable to init calendar %s: %w", name, err)
+		}
+
+		registry.Register(name, calendarBuiltin)
+	}
+
+	return registry, nil
+}
+
+// holidaySets returns the bundled holiday sets by calendar name.
+func holidaySets() map[string][]calendar.HolidayRule {
+	sets := map[string][]calendar.HolidayRule{
+		"hu": hu.Holidays(),
+		"us": us.Holidays(),
+		"de": de.Holidays(de.Nationwide),
+	}
+
+	for _, state := range []de.State{
+		de.BadenWuerttemberg, de.Bayern, de.Berlin, de.Brandenburg, de.Bremen, de.Hamburg, de.Hessen,
+		de.MecklenburgVorpommern, de.Niedersachsen, de.NordrheinWestfalen, de.RheinlandPfalz, de.Saarland,
+		de.Sachsen, de.SachsenAnhalt, de.SchleswigHolstein, de.Thueringen,
+	} {
+		sets["de-"+strings.ToLower(string(state))] = de.Holidays(state)
+	}
+
+	return sets
+}
+
+func defaultConfig(holidays []calendar.HolidayRule) calendar.Config {
+	return calendar.Config{
+		FirstWorkday:   calendar.FirstWorkdayDefault,
+		WorkdaysInWeek: calendar.WorkdaysInWeekDefault,
+		WorkBegins:     calendar.WorkBeginsDefault,
+		WorkEnds:       calendar.WorkEndsDefault,
+		TimeFormat:     calendar.TimeFormatDefault,
+		Holidays:       holidays,
+		Overrides:      nil,
+	}
+}
+
+// Register adds or replaces the named calendar.
+func (registry *Registry) Register(name string, calendarNamed *calendar.Calendar) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.calendars[name] = calendarNamed
+}
+
+// Calendar returns the named calendar. Empty name means DefaultName.
+func (registry *Registry) Calendar(name string) (*calendar.Calendar, error) {
+	if name == "" {
+		name = DefaultName
+	}
+
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	calendarNamed, has := registry.calendars[name]
+	if !has {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCalendar, name)
+	}
+
+	return calendarNamed, nil
+}
+
+func (registry *Registry) Names() []string {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	names := make([]string, 0, len(registry.calendars))
+	for name := range registry.calendars {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,74 @@
+package registry_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/registry"
+)
+
+type RegistryTestSuite struct {
+	suite.Suite
+}
+
+func TestRegistryTestSuite(t *testing.T) {
+	suite.Run(t, new(RegistryTestSuite))
+}
+
+func (s *RegistryTestSuite) TestLoadConfig() {
+	config, err := registry.LoadConfig(strings.NewReader(`{
+		"firstWorkday": "sunday",
+		"workdaysInWeek": 5,
+		"workBegins": "08:30",
+		"workEnds": "16:30",
+		"location": "UTC",
+		"holidaySets": ["hu"],
+		"holidays": [{"date": "2021-12-24", "name": "Szenteste", "workBegins": "08:30", "workEnds": "12:00"}],
+		"overrides": [{"date": "2021-12-11", "working": true}]
+	}`))
+	s.Require().NoError(err)
+
+	s.Assert().Equal(time.Sunday, config.FirstWorkday)
+	s.Assert().Equal(8*time.Hour+30*time.Minute, config.WorkBegins)
+	s.Assert().Equal(16*time.Hour+30*time.Minute, config.WorkEnds)
+	s.Assert().Equal(calendar.TimeFormatDefault, config.TimeFormat)
+	s.Assert().Equal(time.UTC, config.Location)
+
+	calendarLoaded, err := calendar.NewCalendar(config)
+	s.Require().NoError(err)
+
+	s.Assert().Equal([]calendar.Holiday{
+		{
+			Date:       calendar.NewDate(2021, time.December, 24),
+			Name:       "Szenteste",
+			WorkBegins: 8*time.Hour + 30*time.Minute,
+			WorkEnds:   12 * time.Hour,
+		},
+		{Date: calendar.NewDate(2021, time.December, 25), Name: "Karácsony", WorkBegins: 0, WorkEnds: 0},
+	}, calendarLoaded.Holidays(calendar.NewDate(2021, time.December, 24), calendar.NewDate(2021, time.December, 25)))
+	s.Assert().Empty(
+		calendarLoaded.Holidays(calendar.NewDate(2022, time.December, 24), calendar.NewDate(2022, time.December, 24)),
+	)
+	s.Assert().True(calendarLoaded.IsWorkday(calendar.NewDate(2021, time.December, 11)))
+}
+
+func (s *RegistryTestSuite) TestLoadConfigInvalid() {
+	for _, config := range []string{
+		`{"firstWorkday": "Mon"}`,
+		`{"workBegins": "9"}`,
+		`{"workEnds": "17:60"}`,
+		`{"workEnds": "17:5"}`,
+		`{"location": "Nowhere/City"}`,
+		`{"holidaySets": ["xx"]}`,
+		`{"holidays": [{"date": "2021-13-01", "name": "X"}]}`,
+		`{"overrides": [{"date": "2021-12-11", "working": true, "workBegins": "x"}]}`,
+		`{"unknown": 1}`,
+	} {
+		_, err := registry.LoadConfig(strings.NewReader(config))
+		s.Assert().ErrorIs(err, registry.ErrInvalidConfig, config)
+	}
+}
@@ -0,0 +1,374 @@
+// Package server exposes the calendar calculations as an HTTP JSON service.
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/registry"
+)
+
+const (
+	PathDueDate         = "/v1/due-date"
+	PathWorkingDuration = "/v1/working-duration"
+	PathIsWorkingTime   = "/v1/is-working-time"
+	PathCalendars       = "/v1/calendars"
+
+	ErrorCodeInvalidRequest      = "invalid_request"
+	ErrorCodeInvalidSubmitTime   = "invalid_submit_time"
+	ErrorCodeUnknownCalendar     = "unknown_calendar"
+	ErrorCodeMethodNotAllowed    = "method_not_allowed"
+	ErrorCodeInternalServerError = "internal_server_error"
+)
+
+var ErrMethodNotAllowed = errors.New("method not allowed")
+
+// maxRequestBytes is the limit of the request body.
+const maxRequestBytes = 1 << 20
+
+// DueDateRequest returns the steps of the calculation, too, if Explain is set.
+type DueDateRequest struct {
+	Calendar        string    `json:"calendar"`
+	SubmitAt        time.Time `json:"submitAt"`
+	TurnaroundHours float64   `json:"turnaroundHours"`
+	Explain         bool      `json:"explain,omitempty"`
+}
+
+type DueDateResponse struct {
+	Calendar string      `json:"calendar"`
+	DueAt    time.Time   `json:"dueAt"`
+	Trace    []TraceStep `json:"trace,omitempty"`
+}
+
+// TraceStep is a step of the due-date calculation, see calendar.TraceStep.
+type TraceStep struct {
+	Kind           string    `json:"kind"`
+	Date           string    `json:"date"`
+	Start          time.Time `json:"start"`
+	End            time.Time `json:"end"`
+	ConsumedHours  float64   `json:"consumedHours"`
+	RemainingHours float64   `json:"remainingHours"`
+	Note           string    `json:"note,omitempty"`
+}
+
+type WorkingDurationRequest struct {
+	Calendar string    `json:"calendar"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+}
+
+type WorkingDurationResponse struct {
+	Calendar        string  `json:"calendar"`
+	WorkingHours    float64 `json:"workingHours"`
+	WorkingDuration string  `json:"workingDuration"`
+}
+
+type IsWorkingTimeRequest struct {
+	Calendar string    `json:"calendar"`
+	At       time.Time `json:"at"`
+}
+
+type IsWorkingTimeResponse struct {
+	Calendar    string `json:"calendar"`
+	WorkingTime bool   `json:"workingTime"`
+}
+
+type CalendarsResponse struct {
+	Calendars []string `json:"calendars"`
+}
+
+type ErrorResponse struct {
+	Error ErrorBody `json:"error"`
+}
+
+type ErrorBody struct {
+	Code    string             `json:"code"`
+	Message string             `json:"message"`
+	Details *SubmitTimeDetails `json:"details,omitempty"`
+}
+
+// SubmitTimeDetails is the context of an invalid submit time, see calendar.SubmitTimeError.
+type SubmitTimeDetails struct {
+	SubmitAt     time.Time  `json:"submitAt"`
+	Reason       string     `json:"reason"`
+	WindowStart  *time.Time `json:"windowStart,omitempty"`
+	WindowEnd    *time.Time `json:"windowEnd,omitempty"`
+	FirstWorkday string     `json:"firstWorkday,omitempty"`
+	LastWorkday  string     `json:"lastWorkday,omitempty"`
+	Holiday      string     `json:"holiday,omitempty"`
+}
+
+type Server struct {
+	registry *registry.Registry
+	mux      *http.ServeMux
+}
+
+func New(calendars *registry.Registry) *Server {
+	server := &Server{
+		registry: calendars,
+		mux:      http.NewServeMux(),
+	}
+
+	server.mux.HandleFunc(PathDueDate, server.handleDueDate)
+	server.mux.HandleFunc(PathWorkingDuration, server.handleWorkingDuration)
+	server.mux.HandleFunc(PathIsWorkingTime, server.handleIsWorkingTime)
+	server.mux.HandleFunc(PathCalendars, server.handleCalendars)
+
+	return server
+}
+
+func (server *Server) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	server.mux.ServeHTTP(writer, request)
+}
+
+func (server *Server) handleDueDate(writer http.ResponseWriter, request *http.Request) {
+	var dueDateRequest DueDateRequest
+	if err := decodeRequest(writer, request, &dueDateRequest); err != nil {
+		writeError(writer, request, err)
+
+		return
+	}
+
+	if err := calendar.ValidateTurnaroundHours(dueDateRequest.TurnaroundHours); err != nil {
+		writeError(writer, request, requestError{err: err})
+
+		return
+	}
+
+	calendarNamed, err := server.registry.Calendar(dueDateRequest.Calendar)
+	if err != nil {
+		writeError(writer, request, err)
+
+		return
+	}
+
+	if dueDateRequest.Explain {
+		trace, err := calendarNamed.CalculateDueDateTraceLocale(
+			dueDateRequest.SubmitAt, dueDateRequest.TurnaroundHours, requestLocale(request),
+		)
+		if err != nil {
+			writeError(writer, request, err)
+
+			return
+		}
+
+		writeResponse(writer, http.StatusOK, DueDateResponse{
+			Calendar: calendarName(dueDateRequest.Calendar),
+			DueAt:    trace.DueAt,
+			Trace:    traceSteps(trace.Steps),
+		})
+
+		return
+	}
+
+	dueAt, err := calendarNamed.CalculateDueDate(dueDateRequest.SubmitAt, dueDateRequest.TurnaroundHours)
+	if err != nil {
+		writeError(writer, request, err)
+
+		return
+	}
+
+	writeResponse(writer, http.StatusOK, DueDateResponse{
+		Calendar: calendarName(dueDateRequest.Calendar),
+		DueAt:    dueAt,
+		Trace:    nil,
+	})
+}
+
+func traceSteps(steps []calendar.TraceStep) []TraceStep {
+	traceSteps := make([]TraceStep, 0, len(steps))
+
+	for _, step := range steps {
+		traceSteps = append(traceSteps, TraceStep{
+			Kind:           string(step.Kind),
+			Date:           step.Date.String(),
+			Start:          step.Start,
+			End:            step.End,
+			ConsumedHours:  step.Consumed.Hours(),
+			RemainingHours: step.Remaining.Hours(),
+			Note:           step.Note,
+		})
+	}
+
+	return traceSteps
+}
+
+func (server *Server) handleWorkingDuration(writer http.ResponseWriter, request *http.Request) {
+	var workingDurationRequest WorkingDurationRequest
+	if err := decodeRequest(writer, request, &workingDurationRequest); err != nil {
+		writeError(writer, request, err)
+
+		return
+	}
+
+	calendarNamed, err := server.registry.Calendar(workingDurationRequest.Calendar)
+	if err != nil {
+		writeError(writer, request, err)
+
+		return
+	}
+
+	workingDuration := calendarNamed.WorkingDurationBetween(workingDurationRequest.From, workingDurationRequest.To)
+	if err := calendar.ValidateWorkingDuration(workingDuration); err != nil {
+		writeError(writer, request, requestError{err: err})
+
+		return
+	}
+
+	writeResponse(writer, http.StatusOK, WorkingDurationResponse{
+		Calendar:        calendarName(workingDurationRequest.Calendar),
+		WorkingHours:    workingDuration.Hours(),
+		WorkingDuration: workingDuration.String(),
+	})
+}
+
+func (server *Server) handleIsWorkingTime(writer http.ResponseWriter, request *http.Request) {
+	var isWorkingTimeRequest IsWorkingTimeRequest
+	if err := decodeRequest(writer, request, &isWorkingTimeRequest); err != nil {
+		writeError(writer, request, err)
+
+		return
+	}
+
+	calendarNamed, err := server.registry.Calendar(isWorkingTimeRequest.Calendar)
+	if err != nil {
+		writeError(writer, request, err)
+
+		return
+	}
+
+	writeResponse(writer, http.StatusOK, IsWorkingTimeResponse{
+		Calendar:    calendarName(isWorkingTimeRequest.Calendar),
+		WorkingTime: calendarNamed.IsWorkingTime(isWorkingTimeRequest.At),
+	})
+}
+
+func (server *Server) handleCalendars(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		writeError(writer, request, fmt.Errorf("%w: %s", ErrMethodNotAllowed, request.Method))
+
+		return
+	}
+
+	writeResponse(writer, http.StatusOK, CalendarsResponse{
+		Calendars: server.registry.Names(),
+	})
+}
+
+func calendarName(name string) string {
+	if name == "" {
+		return registry.DefaultName
+	}
+
+	return name
+}
+
+type requestError struct {
+	err error
+}
+
+func (err requestError) Error() string {
+	return err.err.Error()
+}
+
+func (err requestError) Unwrap() error {
+	return err.err
+}
+
+// decodeRequest decodes the JSON body of a POST request, up to maxRequestBytes.
+func decodeRequest(writer http.ResponseWriter, request *http.Request, body interface{}) error {
+	if request.Method != http.MethodPost {
+		return fmt.Errorf("%w: %s", ErrMethodNotAllowed, request.Method)
+	}
+
+	decoder := json.NewDecoder(http.MaxBytesReader(writer, request.Body, maxRequestBytes))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(body); err != nil {
+		return requestError{err: fmt.Errorf("invalid request body: %w", err)}
+	}
+
+	return nil
+}
+
+func errorStatus(err error) (int, string) {
+	var errRequest requestError
+
+	switch {
+	case errors.As(err, &errRequest):
+		return http.StatusBadRequest, ErrorCodeInvalidRequest
+	case errors.Is(err, ErrMethodNotAllowed):
+		return http.StatusMethodNotAllowed, ErrorCodeMethodNotAllowed
+	case errors.Is(err, registry.ErrUnknownCalendar):
+		return http.StatusNotFound, ErrorCodeUnknownCalendar
+	case errors.Is(err, calendar.ErrInvalidSubmitTime):
+		return http.StatusUnprocessableEntity, ErrorCodeInvalidSubmitTime
+	default:
+		return http.StatusInternalServerError, ErrorCodeInternalServerError
+	}
+}
+
+// writeError writes the message in the language of the Accept-Language header, or in English.
+func writeError(writer http.ResponseWriter, request *http.Request, err error) {
+	status, code := errorStatus(err)
+
+	writeResponse(writer, status, ErrorResponse{
+		Error: ErrorBody{
+			Code:    code,
+			Message: requestLocale(request).Message(err),
+			Details: submitTimeDetails(err),
+		},
+	})
+}
+
+func requestLocale(request *http.Request) calendar.Locale {
+	for _, tag := range strings.Split(request.Header.Get("Accept-Language"), ",") {
+		language := strings.SplitN(tag, ";", 2)[0] //nolint:gomnd // tag;q=
+		if locale, supported := calendar.ParseLocale(language); supported {
+			return locale
+		}
+	}
+
+	return calendar.LocaleEnglish
+}
+
+func submitTimeDetails(err error) *SubmitTimeDetails {
+	var submitTimeError *calendar.SubmitTimeError
+	if !errors.As(err, &submitTimeError) {
+		return nil
+	}
+
+	details := &SubmitTimeDetails{
+		SubmitAt:     submitTimeError.SubmitAt,
+		Reason:       string(submitTimeError.Reason),
+		WindowStart:  nil,
+		WindowEnd:    nil,
+		FirstWorkday: "",
+		LastWorkday:  "",
+		Holiday:      submitTimeError.Holiday,
+	}
+
+	switch submitTimeError.Reason {
+	case calendar.ReasonWorkHours:
+		details.WindowStart = &submitTimeError.WindowStart
+		details.WindowEnd = &submitTimeError.WindowEnd
+	case calendar.ReasonWeekday:
+		details.FirstWorkday = submitTimeError.FirstWorkday.String()
+		details.LastWorkday = submitTimeError.LastWorkday.String()
+	case calendar.ReasonDayOff, calendar.ReasonHoliday:
+	}
+
+	return details
+}
+
+func writeResponse(writer http.ResponseWriter, status int, body interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+
+	_ = json.NewEncoder(writer).Encode(body) //nolint:errchkjson // the status is already sent
+}
@@ -0,0 +1,400 @@
+package server_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pgillich/date_calculator/pkg/registry"
+	"github.com/pgillich/date_calculator/pkg/server"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServerTestSuite struct {
+	suite.Suite
+
+	server *httptest.Server
+}
+
+func TestServerTestSuite(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
+
+func (s *ServerTestSuite) SetupSuite() {
+	calendars, err := registry.Builtin()
+	s.Require().NoError(err)
+
+	s.server = httptest.NewServer(server.New(calendars))
+}
+
+func (s *ServerTestSuite) TearDownSuite() {
+	s.server.Close()
+}
+
+func (s *ServerTestSuite) TestEndpoints() {
+	testCases := []struct {
+		name string
+
+		method string
+		path   string
+		body   string
+
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		{
+			name:           "Due date",
+			method:         http.MethodPost,
+			path:           server.PathDueDate,
+			body:           `{"calendar": "hu", "submitAt": "2021-10-29T16:00:00+02:00", "turnaroundHours": 2}`,
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"calendar": "hu",
+				"dueAt":    "2021-11-02T10:00:00+02:00",
+			},
+		},
+		{
+			name:           "Due date explained",
+			method:         http.MethodPost,
+			path:           server.PathDueDate,
+			body:           `{"calendar": "hu", "submitAt": "2021-10-29T16:00:00+02:00", "turnaroundHours": 2, "explain": true}`,
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"calendar": "hu",
+				"dueAt":    "2021-11-02T10:00:00+02:00",
+				"trace": []interface{}{
+					map[string]interface{}{
+						"kind": "segment", "date": "2021-10-29",
+						"start": "2021-10-29T16:00:00+02:00", "end": "2021-10-29T17:00:00+02:00",
+						"consumedHours": 1.0, "remainingHours": 1.0,
+					},
+					map[string]interface{}{
+						"kind": "skip", "date": "2021-10-30",
+						"start": "2021-10-30T00:00:00+02:00", "end": "2021-10-31T00:00:00+02:00",
+						"consumedHours": 0.0, "remainingHours": 1.0, "note": "Saturday",
+					},
+					map[string]interface{}{
+						"kind": "skip", "date": "2021-10-31",
+						"start": "2021-10-31T00:00:00+02:00", "end": "2021-11-01T00:00:00+02:00",
+						"consumedHours": 0.0, "remainingHours": 1.0, "note": "Sunday",
+					},
+					map[string]interface{}{
+						"kind": "skip", "date": "2021-11-01",
+						"start": "2021-11-01T00:00:00+02:00", "end": "2021-11-02T00:00:00+02:00",
+						"consumedHours": 0.0, "remainingHours": 1.0, "note": "Mindenszentek",
+					},
+					map[string]interface{}{
+						"kind": "segment", "date": "2021-11-02",
+						"start": "2021-11-02T09:00:00+02:00", "end": "2021-11-02T10:00:00+02:00",
+						"consumedHours": 1.0, "remainingHours": 0.0,
+					},
+				},
+			},
+		},
+		{
+			name:           "Due date by default calendar",
+			method:         http.MethodPost,
+			path:           server.PathDueDate,
+			body:           `{"submitAt": "2021-10-29T16:00:00+02:00", "turnaroundHours": 2}`,
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"calendar": "default",
+				"dueAt":    "2021-11-01T10:00:00+02:00",
+			},
+		},
+		{
+			name:           "Invalid submit time",
+			method:         http.MethodPost,
+			path:           server.PathDueDate,
+			body:           `{"calendar": "hu", "submitAt": "2021-11-01T10:00:00+01:00", "turnaroundHours": 2}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    server.ErrorCodeInvalidSubmitTime,
+					"message": "invalid submit datetime: 2021-11-01T10:00:00+01:00, Mindenszentek is a holiday",
+					"details": map[string]interface{}{
+						"submitAt": "2021-11-01T10:00:00+01:00",
+						"reason":   "holiday",
+						"holiday":  "Mindenszentek",
+					},
+				},
+			},
+		},
+		{
+			name:           "Submit time out of working hours",
+			method:         http.MethodPost,
+			path:           server.PathDueDate,
+			body:           `{"calendar": "hu", "submitAt": "2021-11-02T18:00:00+01:00", "turnaroundHours": 2}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": server.ErrorCodeInvalidSubmitTime,
+					"message": "invalid submit datetime: 2021-11-02T18:00:00+01:00, " +
+						"must be 2021-11-02T09:00:00+01:00 - 2021-11-02T17:00:00+01:00",
+					"details": map[string]interface{}{
+						"submitAt":    "2021-11-02T18:00:00+01:00",
+						"reason":      "work_hours",
+						"windowStart": "2021-11-02T09:00:00+01:00",
+						"windowEnd":   "2021-11-02T17:00:00+01:00",
+					},
+				},
+			},
+		},
+		{
+			name:           "Unknown calendar",
+			method:         http.MethodPost,
+			path:           server.PathDueDate,
+			body:           `{"calendar": "xx", "submitAt": "2021-10-29T16:00:00+02:00", "turnaroundHours": 2}`,
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    server.ErrorCodeUnknownCalendar,
+					"message": "unknown calendar: xx",
+				},
+			},
+		},
+		{
+			name:           "Unknown field",
+			method:         http.MethodPost,
+			path:           server.PathDueDate,
+			body:           `{"submitTime": "2021-10-29T16:00:00+02:00", "turnaroundHours": 2}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    server.ErrorCodeInvalidRequest,
+					"message": `invalid request body: json: unknown field "submitTime"`,
+				},
+			},
+		},
+		{
+			name:           "Negative turnaround",
+			method:         http.MethodPost,
+			path:           server.PathDueDate,
+			body:           `{"calendar": "hu", "submitAt": "2021-10-29T16:00:00+02:00", "turnaroundHours": -2}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    server.ErrorCodeInvalidRequest,
+					"message": "invalid turnaround: -2 hours",
+				},
+			},
+		},
+		{
+			name:           "Too long turnaround",
+			method:         http.MethodPost,
+			path:           server.PathDueDate,
+			body:           `{"calendar": "hu", "submitAt": "2021-10-29T16:00:00+02:00", "turnaroundHours": 1e300}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    server.ErrorCodeInvalidRequest,
+					"message": "invalid turnaround: 1e+300 hours",
+				},
+			},
+		},
+		{
+			name:           "Not a number turnaround",
+			method:         http.MethodPost,
+			path:           server.PathDueDate,
+			body:           `{"calendar": "hu", "submitAt": "2021-10-29T16:00:00+02:00", "turnaroundHours": NaN}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    server.ErrorCodeInvalidRequest,
+					"message": "invalid request body: invalid character 'N' looking for beginning of value",
+				},
+			},
+		},
+		{
+			name:           "Too large body",
+			method:         http.MethodPost,
+			path:           server.PathDueDate,
+			body:           `{"calendar": "` + strings.Repeat("x", 1<<20) + `"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    server.ErrorCodeInvalidRequest,
+					"message": "invalid request body: http: request body too large",
+				},
+			},
+		},
+		{
+			name:           "Wrong method",
+			method:         http.MethodGet,
+			path:           server.PathDueDate,
+			body:           ``,
+			expectedStatus: http.StatusMethodNotAllowed,
+			expectedBody: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    server.ErrorCodeMethodNotAllowed,
+					"message": "method not allowed: GET",
+				},
+			},
+		},
+		{
+			name:           "Working duration",
+			method:         http.MethodPost,
+			path:           server.PathWorkingDuration,
+			body:           `{"calendar": "hu", "from": "2021-10-29T16:00:00+02:00", "to": "2021-11-02T10:30:00+02:00"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"calendar":        "hu",
+				"workingHours":    2.5,
+				"workingDuration": "2h30m0s",
+			},
+		},
+		{
+			name:           "Too long working duration",
+			method:         http.MethodPost,
+			path:           server.PathWorkingDuration,
+			body:           `{"calendar": "hu", "from": "0001-01-01T00:00:00Z", "to": "9999-12-31T00:00:00Z"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    server.ErrorCodeInvalidRequest,
+					"message": "invalid working duration: longer than 2562047h47m16.854775807s",
+				},
+			},
+		},
+		{
+			name:           "Is working time",
+			method:         http.MethodPost,
+			path:           server.PathIsWorkingTime,
+			body:           `{"calendar": "us", "at": "2021-07-05T10:00:00-04:00"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"calendar":    "us",
+				"workingTime": false,
+			},
+		},
+		{
+			name:           "Calendars",
+			method:         http.MethodGet,
+			path:           server.PathCalendars,
+			body:           ``,
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"calendars": []interface{}{
+					"24x7", "de", "de-bb", "de-be", "de-bw", "de-by", "de-hb", "de-he", "de-hh", "de-mv", "de-ni", "de-nw",
+					"de-rp", "de-sh", "de-sl", "de-sn", "de-st", "de-th", "default", "hu", "us",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			request, err := http.NewRequest(testCase.method, s.server.URL+testCase.path, strings.NewReader(testCase.body))
+			s.Require().NoError(err)
+
+			response, err := s.server.Client().Do(request)
+			s.Require().NoError(err)
+			defer response.Body.Close()
+
+			s.Assert().Equal(testCase.expectedStatus, response.StatusCode)
+			s.Assert().Equal("application/json", response.Header.Get("Content-Type"))
+
+			var body interface{}
+			s.Assert().NoError(json.NewDecoder(response.Body).Decode(&body))
+			s.Assert().Equal(testCase.expectedBody, body)
+		})
+	}
+}
+
+func (s *ServerTestSuite) TestLocalizedError() {
+	testCases := []struct {
+		name string
+
+		acceptLanguage string
+		body           string
+
+		expectedMessage string
+	}{
+		{
+			name:            "Hungarian",
+			acceptLanguage:  "hu-HU,hu;q=0.9,en;q=0.8",
+			body:            `{"calendar": "hu", "submitAt": "2021-11-01T10:00:00+01:00", "turnaroundHours": 2}`,
+			expectedMessage: "érvénytelen beküldési időpont: 2021.11.01. 10:00, Mindenszentek ünnepnap",
+		},
+		{
+			name:            "German",
+			acceptLanguage:  "fr, de;q=0.5",
+			body:            `{"calendar": "de", "submitAt": "2021-11-02T18:00:00+01:00", "turnaroundHours": 2}`,
+			expectedMessage: "ungültiger Einreichungszeitpunkt: 02.11.2021 18:00, muss zwischen 02.11.2021 09:00 und 02.11.2021 17:00 liegen",
+		},
+		{
+			name:            "Unsupported",
+			acceptLanguage:  "fr",
+			body:            `{"calendar": "hu", "submitAt": "2021-11-01T10:00:00+01:00", "turnaroundHours": 2}`,
+			expectedMessage: "invalid submit datetime: 2021-11-01T10:00:00+01:00, Mindenszentek is a holiday",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			request, err := http.NewRequest(
+				http.MethodPost, s.server.URL+server.PathDueDate, strings.NewReader(testCase.body),
+			)
+			s.Require().NoError(err)
+			request.Header.Set("Accept-Language", testCase.acceptLanguage)
+
+			response, err := s.server.Client().Do(request)
+			s.Require().NoError(err)
+			defer response.Body.Close()
+
+			s.Assert().Equal(http.StatusUnprocessableEntity, response.StatusCode)
+
+			var body server.ErrorResponse
+			s.Assert().NoError(json.NewDecoder(response.Body).Decode(&body))
+			s.Assert().Equal(server.ErrorCodeInvalidSubmitTime, body.Error.Code)
+			s.Assert().Equal(testCase.expectedMessage, body.Error.Message)
+		})
+	}
+}
+
+func (s *ServerTestSuite) TestLocalizedRequestError() {
+	request, err := http.NewRequest(http.MethodPost, s.server.URL+server.PathDueDate, strings.NewReader(
+		`{"calendar": "hu", "submitAt": "2021-10-29T16:00:00+02:00", "turnaroundHours": -2}`,
+	))
+	s.Require().NoError(err)
+	request.Header.Set("Accept-Language", "hu")
+
+	response, err := s.server.Client().Do(request)
+	s.Require().NoError(err)
+	defer response.Body.Close()
+
+	s.Assert().Equal(http.StatusBadRequest, response.StatusCode)
+
+	var body server.ErrorResponse
+	s.Assert().NoError(json.NewDecoder(response.Body).Decode(&body))
+	s.Assert().Equal(server.ErrorCodeInvalidRequest, body.Error.Code)
+	s.Assert().Equal("érvénytelen átfutási idő: -2 hours", body.Error.Message)
+}
+
+func (s *ServerTestSuite) TestLocalizedTrace() {
+	request, err := http.NewRequest(http.MethodPost, s.server.URL+server.PathDueDate, strings.NewReader(
+		`{"calendar": "hu", "submitAt": "2021-10-29T16:00:00+02:00", "turnaroundHours": 2, "explain": true}`,
+	))
+	s.Require().NoError(err)
+	request.Header.Set("Accept-Language", "de")
+
+	response, err := s.server.Client().Do(request)
+	s.Require().NoError(err)
+	defer response.Body.Close()
+
+	s.Assert().Equal(http.StatusOK, response.StatusCode)
+
+	var body server.DueDateResponse
+	s.Assert().NoError(json.NewDecoder(response.Body).Decode(&body))
+
+	notes := []string{}
+	for _, step := range body.Trace {
+		notes = append(notes, step.Note)
+	}
+
+	s.Assert().Equal([]string{"", "Samstag", "Sonntag", "Mindenszentek", ""}, notes)
+}
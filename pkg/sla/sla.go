@@ -0,0 +1,232 @@
+// Package sla calculates due dates by priority and category based rules, for example of a ticketing system.
+package sla
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/registry"
+)
+
+var (
+	ErrInvalidRule = errors.New("invalid SLA rule")
+	ErrNoRule      = errors.New("no SLA rule")
+)
+
+// Rule sets the calendar and the turnaround of a priority. An empty Category is the fallback of the priority.
+// The turnaround is TurnaroundHours, TurnaroundDays or Turnaround (ISO 8601 duration, for example "P2DT4H"),
+// a working day (D) is the daily working time of the calendar.
+// AtRiskPercent is calendar.AtRiskPercentDefault, if not set.
+type Rule struct {
+	Priority        string  `json:"priority"`
+	Category        string  `json:"category,omitempty"`
+	Calendar        string  `json:"calendar,omitempty"`
+	TurnaroundHours float64 `json:"turnaroundHours,omitempty"`
+	TurnaroundDays  float64 `json:"turnaroundDays,omitempty"`
+	Turnaround      string  `json:"turnaround,omitempty"`
+	AtRiskPercent   float64 `json:"atRiskPercent,omitempty"`
+}
+
+type Config struct {
+	Rules []Rule `json:"rules"`
+}
+
+type ruleKey struct {
+	priority string
+	category string
+}
+
+// Policy selects the rule of a ticket. The calendars are looked up in the registry at each calculation.
+type Policy struct {
+	calendars *registry.Registry
+	rules     map[ruleKey]Rule
+}
+
+func NewPolicy(config Config, calendars *registry.Registry) (*Policy, error) {
+	policy := &Policy{
+		calendars: calendars,
+		rules:     map[ruleKey]Rule{},
+	}
+
+	for _, rule := range config.Rules {
+		key := ruleKey{priority: rule.Priority, category: rule.Category}
+
+		if _, has := policy.rules[key]; has {
+			return nil, fmt.Errorf("%w: %s/%s is duplicated", ErrInvalidRule, rule.Priority, rule.Category)
+		}
+
+		if err := validateRule(rule, calendars); err != nil {
+			return nil, err
+		}
+
+		policy.rules[key] = rule
+	}
+
+	return policy, nil
+}
+
+// LoadPolicy reads the Config in JSON format.
+func LoadPolicy(reader io.Reader, calendars *registry.Registry) (*Policy, error) {
+	var config Config
+
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("invalid SLA config: %w", err)
+	}
+
+	return NewPolicy(config, calendars)
+}
+
+func validateRule(rule Rule, calendars *registry.Registry) error {
+	if rule.Priority == "" {
+		return fmt.Errorf("%w: missing priority", ErrInvalidRule)
+	}
+
+	turnarounds := 0
+
+	for _, isSet := range []bool{rule.TurnaroundHours != 0, rule.TurnaroundDays != 0, rule.Turnaround != ""} {
+		if isSet {
+			turnarounds++
+		}
+	}
+
+	if rule.TurnaroundHours < 0 || rule.TurnaroundDays < 0 || turnarounds != 1 {
+		return fmt.Errorf(
+			"%w: %s/%s, turnaround must be either hours, days or ISO 8601 duration",
+			ErrInvalidRule, rule.Priority, rule.Category,
+		)
+	}
+
+	if rule.AtRiskPercent < 0 {
+		return fmt.Errorf("%w: %s/%s, negative at-risk percent", ErrInvalidRule, rule.Priority, rule.Category)
+	}
+
+	calendarRule, err := calendars.Calendar(rule.Calendar)
+	if err != nil {
+		return fmt.Errorf("%w: %s/%s, %s", ErrInvalidRule, rule.Priority, rule.Category, err.Error())
+	}
+
+	if rule.Turnaround != "" {
+		if turnaround, err := calendarRule.ParseISODuration(rule.Turnaround); err != nil || turnaround < 0 {
+			return fmt.Errorf(
+				"%w: %s/%s, invalid turnaround %q", ErrInvalidRule, rule.Priority, rule.Category, rule.Turnaround,
+			)
+		}
+	}
+
+	return nil
+}
+
+// Rule returns the rule of the priority and the category, or the fallback rule of the priority.
+func (policy *Policy) Rule(priority, category string) (Rule, error) {
+	if rule, has := policy.rules[ruleKey{priority: priority, category: category}]; has {
+		return rule, nil
+	}
+
+	if rule, has := policy.rules[ruleKey{priority: priority, category: ""}]; has {
+		return rule, nil
+	}
+
+	return Rule{}, fmt.Errorf("%w: %s/%s", ErrNoRule, priority, category)
+}
+
+// Turnaround returns the calendar and the turnaround of the priority and the category.
+func (policy *Policy) Turnaround(priority, category string) (*calendar.Calendar, time.Duration, error) {
+	rule, err := policy.Rule(priority, category)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	calendarRule, err := policy.calendars.Calendar(rule.Calendar)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s/%s: %w", priority, category, err)
+	}
+
+	if rule.Turnaround != "" {
+		turnaround, err := calendarRule.ParseISODuration(rule.Turnaround)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s/%s: %w", priority, category, err)
+		}
+
+		return calendarRule, turnaround, nil
+	}
+
+	if rule.TurnaroundDays != 0 {
+		return calendarRule, time.Duration(rule.TurnaroundDays * float64(calendarRule.DailyWorkDuration())), nil
+	}
+
+	return calendarRule, calendar.HourToDuration(rule.TurnaroundHours), nil
+}
+
+func (policy *Policy) CalculateDueDate(priority, category string, submitAt time.Time) (time.Time, error) {
+	calendarRule, turnaround, err := policy.Turnaround(priority, category)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return calendarRule.CalculateDueDate(submitAt, turnaround.Hours())
+}
+
+// CalculateTimelineDueDate returns the due date of the ticket, not counting its paused periods.
+func (policy *Policy) CalculateTimelineDueDate(
+	priority, category string, timeline calendar.Timeline,
+) (time.Time, error) {
+	calendarRule, turnaround, err := policy.Turnaround(priority, category)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return calendarRule.CalculateTimelineDueDate(timeline, turnaround.Hours())
+}
+
+// RemainingWorkingDuration returns the working time remained from the turnaround of the ticket at the given time.
+func (policy *Policy) RemainingWorkingDuration(
+	priority, category string, timeline calendar.Timeline, at time.Time,
+) (time.Duration, error) {
+	calendarRule, turnaround, err := policy.Turnaround(priority, category)
+	if err != nil {
+		return 0, err
+	}
+
+	return calendarRule.RemainingWorkingDuration(timeline, turnaround.Hours(), at)
+}
+
+// CalculateProgress returns the progress of the turnaround of the ticket at the given time.
+func (policy *Policy) CalculateProgress(
+	priority, category string, timeline calendar.Timeline, at time.Time,
+) (calendar.Progress, error) {
+	rule, err := policy.Rule(priority, category)
+	if err != nil {
+		return calendar.Progress{}, err
+	}
+
+	calendarRule, turnaround, err := policy.Turnaround(priority, category)
+	if err != nil {
+		return calendar.Progress{}, err
+	}
+
+	atRiskPercent := rule.AtRiskPercent
+	if atRiskPercent == 0 {
+		atRiskPercent = calendar.AtRiskPercentDefault
+	}
+
+	return calendarRule.CalculateTimelineProgress(timeline, turnaround.Hours(), at, atRiskPercent)
+}
+
+// CalculateMilestones returns the times, when the fractions of the turnaround of the ticket elapse.
+func (policy *Policy) CalculateMilestones(
+	priority, category string, submitAt time.Time, fractions []float64,
+) ([]time.Time, error) {
+	calendarRule, turnaround, err := policy.Turnaround(priority, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return calendarRule.CalculateMilestones(submitAt, turnaround.Hours(), fractions)
+}
@@ -0,0 +1,261 @@
+package sla_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/pgillich/date_calculator/pkg/calendar"
+	"github.com/pgillich/date_calculator/pkg/registry"
+	"github.com/pgillich/date_calculator/pkg/sla"
+)
+
+type SLATestSuite struct {
+	suite.Suite
+}
+
+func TestSLATestSuite(t *testing.T) {
+	suite.Run(t, new(SLATestSuite))
+}
+
+func parseTimeRfc3339(value string) time.Time {
+	parsed, _ := time.Parse(time.RFC3339, value)
+
+	return parsed
+}
+
+const policyConfig = `{
+	"rules": [
+		{"priority": "P1", "calendar": "24x7", "turnaroundHours": 4},
+		{"priority": "P2", "calendar": "hu", "turnaroundDays": 2},
+		{"priority": "P2", "category": "contract", "calendar": "hu", "turnaround": "P1DT4H"},
+		{"priority": "P3", "calendar": "hu", "turnaroundDays": 5},
+		{"priority": "P3", "category": "security", "calendar": "hu", "turnaroundDays": 1, "atRiskPercent": 50}
+	]
+}`
+
+func (s *SLATestSuite) TestCalculateDueDate() {
+	calendars, err := registry.Builtin()
+	s.Require().NoError(err)
+
+	policy, err := sla.LoadPolicy(strings.NewReader(policyConfig), calendars)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		priority string
+		category string
+		submitAt time.Time
+
+		expectedDueAt time.Time
+		expectedErr   error
+	}{
+		{
+			name:          "P1 on weekend night",
+			priority:      "P1",
+			submitAt:      parseTimeRfc3339("2021-10-16T22:00:00+02:00"),
+			expectedDueAt: parseTimeRfc3339("2021-10-17T02:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name:          "P2",
+			priority:      "P2",
+			category:      "network",
+			submitAt:      parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+			expectedDueAt: parseTimeRfc3339("2021-10-22T10:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name:          "P2 ISO 8601 duration",
+			priority:      "P2",
+			category:      "contract",
+			submitAt:      parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+			expectedDueAt: parseTimeRfc3339("2021-10-21T14:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name:          "P3",
+			priority:      "P3",
+			submitAt:      parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+			expectedDueAt: parseTimeRfc3339("2021-10-27T10:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name:          "P3 category",
+			priority:      "P3",
+			category:      "security",
+			submitAt:      parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+			expectedDueAt: parseTimeRfc3339("2021-10-21T10:00:00+02:00"),
+			expectedErr:   nil,
+		},
+		{
+			name:          "Unknown priority",
+			priority:      "P4",
+			submitAt:      parseTimeRfc3339("2021-10-20T10:00:00+02:00"),
+			expectedDueAt: time.Time{},
+			expectedErr:   sla.ErrNoRule,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			dueAt, err := policy.CalculateDueDate(testCase.priority, testCase.category, testCase.submitAt)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+			s.Assert().Equal(testCase.expectedDueAt.Format(time.RFC3339), dueAt.Format(time.RFC3339))
+		})
+	}
+}
+
+func (s *SLATestSuite) TestTimeline() {
+	calendars, err := registry.Builtin()
+	s.Require().NoError(err)
+
+	policy, err := sla.LoadPolicy(strings.NewReader(policyConfig), calendars)
+	s.Require().NoError(err)
+
+	timeline := calendar.NewTimeline(parseTimeRfc3339("2021-10-20T10:00:00+02:00"))
+	s.Require().NoError(timeline.Pause(parseTimeRfc3339("2021-10-20T12:00:00+02:00")))
+	s.Require().NoError(timeline.Resume(parseTimeRfc3339("2021-10-21T12:00:00+02:00")))
+
+	dueAt, err := policy.CalculateTimelineDueDate("P2", "", *timeline)
+	s.Require().NoError(err)
+	s.Assert().Equal(parseTimeRfc3339("2021-10-25T10:00:00+02:00"), dueAt)
+
+	remaining, err := policy.RemainingWorkingDuration("P2", "", *timeline, parseTimeRfc3339("2021-10-21T13:00:00+02:00"))
+	s.Require().NoError(err)
+	s.Assert().Equal(13*time.Hour, remaining)
+
+	_, err = policy.CalculateTimelineDueDate("P4", "", *timeline)
+	s.Assert().ErrorIs(err, sla.ErrNoRule)
+}
+
+func (s *SLATestSuite) TestCalculateProgress() {
+	calendars, err := registry.Builtin()
+	s.Require().NoError(err)
+
+	policy, err := sla.LoadPolicy(strings.NewReader(policyConfig), calendars)
+	s.Require().NoError(err)
+
+	timeline := calendar.Timeline{SubmitAt: parseTimeRfc3339("2021-10-20T10:00:00+02:00"), Pauses: nil}
+	at := parseTimeRfc3339("2021-10-20T15:00:00+02:00")
+
+	progress, err := policy.CalculateProgress("P3", "", timeline, at)
+	s.Require().NoError(err)
+	s.Assert().Equal(calendar.StatusOnTrack, progress.Status)
+
+	progress, err = policy.CalculateProgress("P3", "security", timeline, at)
+	s.Require().NoError(err)
+	s.Assert().Equal(calendar.StatusAtRisk, progress.Status)
+	s.Assert().Equal(3*time.Hour, progress.Remaining)
+
+	_, err = policy.CalculateProgress("P4", "", timeline, at)
+	s.Assert().ErrorIs(err, sla.ErrNoRule)
+}
+
+func (s *SLATestSuite) TestCalculateMilestones() {
+	calendars, err := registry.Builtin()
+	s.Require().NoError(err)
+
+	policy, err := sla.LoadPolicy(strings.NewReader(policyConfig), calendars)
+	s.Require().NoError(err)
+
+	milestones, err := policy.CalculateMilestones(
+		"P1", "", parseTimeRfc3339("2021-10-16T22:00:00+02:00"), []float64{0.5, 0.75, 1},
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal([]time.Time{
+		parseTimeRfc3339("2021-10-17T00:00:00+02:00"),
+		parseTimeRfc3339("2021-10-17T01:00:00+02:00"),
+		parseTimeRfc3339("2021-10-17T02:00:00+02:00"),
+	}, milestones)
+
+	_, err = policy.CalculateMilestones("P4", "", parseTimeRfc3339("2021-10-16T22:00:00+02:00"), []float64{0.5})
+	s.Assert().ErrorIs(err, sla.ErrNoRule)
+}
+
+func (s *SLATestSuite) TestLoadPolicy() {
+	calendars, err := registry.Builtin()
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name string
+
+		config string
+
+		expectedErr error
+	}{
+		{
+			name:        "Valid",
+			config:      policyConfig,
+			expectedErr: nil,
+		},
+		{
+			name:        "Default calendar",
+			config:      `{"rules": [{"priority": "P1", "turnaroundHours": 4}]}`,
+			expectedErr: nil,
+		},
+		{
+			name:        "Duplicated rule",
+			config:      `{"rules": [{"priority": "P1", "turnaroundHours": 4}, {"priority": "P1", "turnaroundHours": 8}]}`,
+			expectedErr: sla.ErrInvalidRule,
+		},
+		{
+			name:        "Missing priority",
+			config:      `{"rules": [{"turnaroundHours": 4}]}`,
+			expectedErr: sla.ErrInvalidRule,
+		},
+		{
+			name:        "Hours and days",
+			config:      `{"rules": [{"priority": "P1", "turnaroundHours": 4, "turnaroundDays": 1}]}`,
+			expectedErr: sla.ErrInvalidRule,
+		},
+		{
+			name:        "Hours and ISO 8601 duration",
+			config:      `{"rules": [{"priority": "P1", "turnaroundHours": 4, "turnaround": "PT4H"}]}`,
+			expectedErr: sla.ErrInvalidRule,
+		},
+		{
+			name:        "Invalid ISO 8601 duration",
+			config:      `{"rules": [{"priority": "P1", "turnaround": "P1M"}]}`,
+			expectedErr: sla.ErrInvalidRule,
+		},
+		{
+			name:        "Negative ISO 8601 duration",
+			config:      `{"rules": [{"priority": "P1", "turnaround": "-PT4H"}]}`,
+			expectedErr: sla.ErrInvalidRule,
+		},
+		{
+			name:        "Negative at-risk percent",
+			config:      `{"rules": [{"priority": "P1", "turnaroundHours": 4, "atRiskPercent": -1}]}`,
+			expectedErr: sla.ErrInvalidRule,
+		},
+		{
+			name:        "Missing turnaround",
+			config:      `{"rules": [{"priority": "P1"}]}`,
+			expectedErr: sla.ErrInvalidRule,
+		},
+		{
+			name:        "Unknown calendar",
+			config:      `{"rules": [{"priority": "P1", "calendar": "xx", "turnaroundHours": 4}]}`,
+			expectedErr: sla.ErrInvalidRule,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		s.Run(testCase.name, func() {
+			policy, err := sla.LoadPolicy(strings.NewReader(testCase.config), calendars)
+
+			s.Assert().ErrorIs(err, testCase.expectedErr)
+			s.Assert().Equal(testCase.expectedErr == nil, policy != nil)
+		})
+	}
+
+	_, err = sla.LoadPolicy(strings.NewReader(`{"rules": [], "unknown": 1}`), calendars)
+	s.Assert().Error(err)
+}